@@ -0,0 +1,390 @@
+package telegram
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// callbackSignatureLength is the length of the truncated HMAC-SHA256 signature of the callback data, in bytes.
+const callbackSignatureLength = 8
+
+var (
+	// ErrCallbackDataMalformed is returned by CallbackCodec.Decode if the data was not encoded by the codec.
+	ErrCallbackDataMalformed = errors.New("telegram: malformed callback data")
+	// ErrCallbackDataTampered is returned by CallbackCodec.Decode if the signature of the data does not match.
+	ErrCallbackDataTampered = errors.New("telegram: tampered callback data")
+	// ErrCallbackDataStale is returned by CallbackCodec.Decode if the data is older than the MaxAge of the codec, or it is
+	// missing from its Store.
+	ErrCallbackDataStale = errors.New("telegram: stale callback data")
+	// ErrCallbackDataTooLong is returned by CallbackCodec.Encode if the data is longer than 64 bytes and the codec has no Store.
+	ErrCallbackDataTooLong = errors.New("telegram: callback data longer than 64 bytes")
+)
+
+// CallbackStore stores the payloads of callback data which does not fit in 64 bytes, under a short ID.
+type CallbackStore interface {
+	Put(ctx context.Context, id string, payload []byte) error // Stores the payload under the ID
+	Get(ctx context.Context, id string) ([]byte, bool, error) // Returns the payload stored under the ID, or false if there is none
+}
+
+// CallbackCodec encodes values of type T, e.g. structs of the parameters of an action, into callback data of the form
+// <action>:<payload>. The payload is a compact binary encoding of the exported fields of the value in base64, holding
+// bools, integers, floats, strings, slices, arrays, pointers and structs of them.
+//
+// If the payload does not fit in 64 bytes, it is saved in the Store, and the callback data is <action>:#<id>.
+type CallbackCodec[T any] struct {
+	Action string        // Prefix of the callback data identifying the action, e.g. "page"
+	Key    []byte        // Optional. Key of the HMAC signature of the payload, rejecting the tampered payloads
+	MaxAge time.Duration // Optional. Maximum age of the payload, rejecting the stale payloads
+	Store  CallbackStore // Optional. Store of the payloads which do not fit in the callback data
+}
+
+// Filter matches the callback queries with callback data of the action of the codec.
+func (c *CallbackCodec[T]) Filter() CallbackQueryFilter {
+	return CallbackDataPrefix(c.Action + ":")
+}
+
+// Encode returns the callback data of the value.
+func (c *CallbackCodec[T]) Encode(ctx context.Context, value T) (string, error) {
+	payload := []byte{}
+	if c.MaxAge > 0 {
+		payload = binary.AppendVarint(payload, time.Now().Unix())
+	}
+
+	payload, err := appendCallbackValue(payload, reflect.ValueOf(&value).Elem())
+	if err != nil {
+		return "", fmt.Errorf("telegram: encoding callback data: %w", err)
+	}
+
+	if c.Key != nil {
+		payload = append(payload, c.sign(payload)...)
+	}
+
+	data := c.Action + ":" + base64.RawURLEncoding.EncodeToString(payload)
+	if len(data) <= MaxCallbackDataLength {
+		return data, nil
+	}
+
+	if c.Store == nil {
+		return "", ErrCallbackDataTooLong
+	}
+
+	id := make([]byte, 9)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("telegram: generating callback data id: %w", err)
+	}
+
+	data = c.Action + ":#" + base64.RawURLEncoding.EncodeToString(id)
+	if len(data) > MaxCallbackDataLength {
+		return "", ErrCallbackDataTooLong
+	}
+
+	if err := c.Store.Put(ctx, data, payload); err != nil {
+		return "", fmt.Errorf("telegram: storing callback data: %w", err)
+	}
+
+	return data, nil
+}
+
+// Decode returns the value of the callback data.
+func (c *CallbackCodec[T]) Decode(ctx context.Context, data string) (T, error) {
+	var value T
+
+	encoded, ok := strings.CutPrefix(data, c.Action+":")
+	if !ok {
+		return value, ErrCallbackDataMalformed
+	}
+
+	payload, err := base64.RawURLEncoding.Strict().DecodeString(strings.TrimPrefix(encoded, "#"))
+	if err != nil {
+		return value, ErrCallbackDataMalformed
+	}
+
+	if strings.HasPrefix(encoded, "#") {
+		if c.Store == nil {
+			return value, ErrCallbackDataMalformed
+		}
+
+		stored, found, err := c.Store.Get(ctx, data)
+		if err != nil {
+			return value, fmt.Errorf("telegram: loading callback data: %w", err)
+		}
+
+		if !found {
+			return value, ErrCallbackDataStale
+		}
+
+		payload = stored
+	}
+
+	if c.Key != nil {
+		if len(payload) < callbackSignatureLength {
+			return value, ErrCallbackDataMalformed
+		}
+
+		signature := payload[len(payload)-callbackSignatureLength:]
+		payload = payload[:len(payload)-callbackSignatureLength]
+
+		if !hmac.Equal(signature, c.sign(payload)) {
+			return value, ErrCallbackDataTampered
+		}
+	}
+
+	if c.MaxAge > 0 {
+		created, n := binary.Varint(payload)
+		if n <= 0 {
+			return value, ErrCallbackDataMalformed
+		}
+
+		if time.Since(time.Unix(created, 0)) > c.MaxAge {
+			return value, ErrCallbackDataStale
+		}
+
+		payload = payload[n:]
+	}
+
+	rest, err := readCallbackValue(payload, reflect.ValueOf(&value).Elem())
+	if errors.Is(err, ErrCallbackDataMalformed) || (err == nil && len(rest) > 0) {
+		return value, ErrCallbackDataMalformed
+	}
+
+	if err != nil {
+		return value, fmt.Errorf("telegram: decoding callback data: %w", err)
+	}
+
+	return value, nil
+}
+
+// DecodeQuery returns the value of the callback data of the query.
+func (c *CallbackCodec[T]) DecodeQuery(ctx context.Context, query *CallbackQuery) (T, error) {
+	return c.Decode(ctx, query.Data)
+}
+
+// sign returns the truncated HMAC-SHA256 signature of the action and the payload.
+func (c *CallbackCodec[T]) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.Key)
+	mac.Write([]byte(c.Action + ":"))
+	mac.Write(payload)
+
+	return mac.Sum(nil)[:callbackSignatureLength]
+}
+
+// MemoryCallbackStore is a CallbackStore keeping the payloads in memory for TTL. The zero value keeps them forever.
+type MemoryCallbackStore struct {
+	TTL time.Duration // Optional. Time to keep a payload for
+
+	mu      sync.Mutex
+	entries map[string]memoryCallbackEntry
+	sweepAt int
+}
+
+// memoryCallbackEntry is a payload stored by a MemoryCallbackStore.
+type memoryCallbackEntry struct {
+	payload []byte
+	expires time.Time
+}
+
+// Put stores the payload under the ID.
+func (s *MemoryCallbackStore) Put(ctx context.Context, id string, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = map[string]memoryCallbackEntry{}
+	}
+
+	// Remove the expired entries whenever the number of entries doubles.
+	if s.TTL > 0 && len(s.entries) >= s.sweepAt {
+		now := time.Now()
+
+		for id, entry := range s.entries {
+			if now.After(entry.expires) {
+				delete(s.entries, id)
+			}
+		}
+
+		s.sweepAt = 2*len(s.entries) + 16
+	}
+
+	entry := memoryCallbackEntry{payload: payload}
+	if s.TTL > 0 {
+		entry.expires = time.Now().Add(s.TTL)
+	}
+
+	s.entries[id] = entry
+
+	return nil
+}
+
+// Get returns the payload stored under the ID, or false if there is none or it has expired.
+func (s *MemoryCallbackStore) Get(ctx context.Context, id string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok || (s.TTL > 0 && time.Now().After(entry.expires)) {
+		return nil, false, nil
+	}
+
+	return entry.payload, true, nil
+}
+
+// appendCallbackValue appends the binary encoding of the value to the payload.
+func appendCallbackValue(payload []byte, value reflect.Value) ([]byte, error) {
+	var err error
+
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return append(payload, 1), nil
+		}
+
+		return append(payload, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(payload, value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(payload, value.Uint()), nil
+	case reflect.Float32:
+		return binary.BigEndian.AppendUint32(payload, math.Float32bits(float32(value.Float()))), nil
+	case reflect.Float64:
+		return binary.BigEndian.AppendUint64(payload, math.Float64bits(value.Float())), nil
+	case reflect.String:
+		payload = binary.AppendUvarint(payload, uint64(value.Len()))
+		return append(payload, value.String()...), nil
+	case reflect.Slice:
+		payload = binary.AppendUvarint(payload, uint64(value.Len()))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < value.Len() && err == nil; i++ {
+			payload, err = appendCallbackValue(payload, value.Index(i))
+		}
+
+		return payload, err
+	case reflect.Pointer:
+		if value.IsNil() {
+			return append(payload, 0), nil
+		}
+
+		return appendCallbackValue(append(payload, 1), value.Elem())
+	case reflect.Struct:
+		for i := 0; i < value.NumField() && err == nil; i++ {
+			if value.Type().Field(i).IsExported() {
+				payload, err = appendCallbackValue(payload, value.Field(i))
+			}
+		}
+
+		return payload, err
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", value.Type())
+}
+
+// readCallbackValue reads the binary encoding of the value from the payload, and returns the rest of the payload.
+func readCallbackValue(payload []byte, value reflect.Value) ([]byte, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if len(payload) == 0 || payload[0] > 1 {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetBool(payload[0] == 1)
+
+		return payload[1:], nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, n := binary.Varint(payload)
+		if n <= 0 || value.OverflowInt(number) {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetInt(number)
+
+		return payload[n:], nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number, n := binary.Uvarint(payload)
+		if n <= 0 || value.OverflowUint(number) {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetUint(number)
+
+		return payload[n:], nil
+	case reflect.Float32:
+		if len(payload) < 4 {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(payload))))
+
+		return payload[4:], nil
+	case reflect.Float64:
+		if len(payload) < 8 {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetFloat(math.Float64frombits(binary.BigEndian.Uint64(payload)))
+
+		return payload[8:], nil
+	case reflect.String:
+		length, n := binary.Uvarint(payload)
+		if n <= 0 || length > uint64(len(payload)-n) {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		value.SetString(string(payload[n : n+int(length)]))
+
+		return payload[n+int(length):], nil
+	case reflect.Slice:
+		length, n := binary.Uvarint(payload)
+		if n <= 0 || length > uint64(len(payload)-n) {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		payload = payload[n:]
+		value.Set(reflect.MakeSlice(value.Type(), int(length), int(length)))
+
+		fallthrough
+	case reflect.Array:
+		var err error
+
+		for i := 0; i < value.Len() && err == nil; i++ {
+			payload, err = readCallbackValue(payload, value.Index(i))
+		}
+
+		return payload, err
+	case reflect.Pointer:
+		if len(payload) == 0 || payload[0] > 1 {
+			return nil, ErrCallbackDataMalformed
+		}
+
+		if payload[0] == 0 {
+			value.SetZero()
+			return payload[1:], nil
+		}
+
+		value.Set(reflect.New(value.Type().Elem()))
+
+		return readCallbackValue(payload[1:], value.Elem())
+	case reflect.Struct:
+		var err error
+
+		for i := 0; i < value.NumField() && err == nil; i++ {
+			if value.Type().Field(i).IsExported() {
+				payload, err = readCallbackValue(payload, value.Field(i))
+			}
+		}
+
+		return payload, err
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", value.Type())
+}
@@ -0,0 +1,227 @@
+package telegram
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testAction is a value of the callback data of the tests.
+type testAction struct {
+	Page    int
+	Size    uint8
+	Query   string
+	Ratio   float64
+	Scale   float32
+	Desc    bool
+	Tags    []string
+	Point   [2]int16
+	Limit   *uint16
+	private string
+}
+
+func TestCallbackCodecRoundTrip(t *testing.T) {
+	values := []testAction{
+		{Tags: []string{}},
+		{Page: -3, Size: 255, Query: "é😀", Ratio: 0.25, Scale: 1.5, Desc: true, Tags: []string{"a", ""}, Point: [2]int16{-1, 1}},
+		{Page: 1, Tags: []string{}, Limit: ptr(uint16(500))},
+	}
+
+	codecs := map[string]*CallbackCodec[testAction]{
+		"plain":  {Action: "a"},
+		"signed": {Action: "a", Key: []byte("secret")},
+		"dated":  {Action: "a", Key: []byte("secret"), MaxAge: time.Hour},
+	}
+
+	for name, codec := range codecs {
+		for _, value := range values {
+			data, err := codec.Encode(context.Background(), value)
+			if err != nil {
+				t.Fatalf("%s: Encode(%+v) = %v", name, value, err)
+			}
+
+			if !strings.HasPrefix(data, "a:") || len(data) > MaxCallbackDataLength {
+				t.Errorf("%s: Encode(%+v) = %q", name, value, data)
+			}
+
+			if !codec.Filter().Match(&CallbackQuery{Data: data}) {
+				t.Errorf("%s: Filter() does not match %q", name, data)
+			}
+
+			got, err := codec.DecodeQuery(context.Background(), &CallbackQuery{Data: data})
+			if err != nil || !reflect.DeepEqual(got, value) {
+				t.Errorf("%s: Decode(%q) = %+v, %v, want %+v", name, data, got, err, value)
+			}
+		}
+	}
+}
+
+func TestCallbackCodecRejects(t *testing.T) {
+	ctx := context.Background()
+	codec := &CallbackCodec[testAction]{Action: "page", Key: []byte("secret"), MaxAge: time.Minute}
+
+	data, err := codec.Encode(ctx, testAction{Page: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// encode returns the callback data of the payload, created at the time and signed with the key of the codec.
+	encode := func(created time.Time, payload ...byte) string {
+		payload = append(binary.AppendVarint(nil, created.Unix()), payload...)
+		payload = append(payload, codec.sign(payload)...)
+
+		return "page:" + base64.RawURLEncoding.EncodeToString(payload)
+	}
+
+	// tamper returns the data with a bit of the payload flipped, at the byte at the offset from the end.
+	tamper := func(data string, offset int) string {
+		payload, _ := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(data, "page:"))
+		payload[len(payload)-offset] ^= 1
+
+		return "page:" + base64.RawURLEncoding.EncodeToString(payload)
+	}
+
+	valid, err := appendCallbackValue(nil, reflect.ValueOf(testAction{Page: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last byte of the value is the nil Limit.
+	invalid := append(valid[:len(valid)-1:len(valid)-1], 2)
+
+	tests := []struct {
+		name  string
+		codec *CallbackCodec[testAction]
+		data  string
+		err   error
+	}{
+		{"valid", codec, data, nil},
+		{"tampered value", codec, tamper(data, callbackSignatureLength+1), ErrCallbackDataTampered},
+		{"tampered signature", codec, tamper(data, 1), ErrCallbackDataTampered},
+		{"other key", &CallbackCodec[testAction]{Action: "page", Key: []byte("other"), MaxAge: time.Minute}, data, ErrCallbackDataTampered},
+		{"other action", &CallbackCodec[testAction]{Action: "pages", Key: []byte("secret"), MaxAge: time.Minute}, data, ErrCallbackDataMalformed},
+		{"unsigned", &CallbackCodec[testAction]{Action: "page", MaxAge: time.Minute}, data, ErrCallbackDataMalformed},
+		{"stale", codec, encode(time.Now().Add(-2*time.Minute), valid...), ErrCallbackDataStale},
+		{"fresh", codec, encode(time.Now().Add(-30*time.Second), valid...), nil},
+		{"trailing bytes", codec, encode(time.Now(), append(valid[:len(valid):len(valid)], 0)...), ErrCallbackDataMalformed},
+		{"truncated", codec, encode(time.Now(), valid[:len(valid)-1]...), ErrCallbackDataMalformed},
+		{"invalid pointer", codec, encode(time.Now(), invalid...), ErrCallbackDataMalformed},
+		{"short signature", codec, "page:AAAA", ErrCallbackDataMalformed},
+		{"invalid base64", codec, "page:a+b/", ErrCallbackDataMalformed},
+		{"no action", codec, "AAAA", ErrCallbackDataMalformed},
+		{"stored without a store", codec, "page:#AAAA", ErrCallbackDataMalformed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := test.codec.Decode(ctx, test.data)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Decode(%q) = %+v, %v, want %v", test.data, value, err, test.err)
+			}
+
+			if err == nil && value.Page != 2 {
+				t.Errorf("Decode(%q) = %+v, want page 2", test.data, value)
+			}
+		})
+	}
+}
+
+// failingStore is a CallbackStore failing to store and load the payloads.
+type failingStore struct{}
+
+func (failingStore) Put(ctx context.Context, id string, payload []byte) error {
+	return errors.New("store failed")
+}
+
+func (failingStore) Get(ctx context.Context, id string) ([]byte, bool, error) {
+	return nil, false, errors.New("store failed")
+}
+
+func TestCallbackCodecStore(t *testing.T) {
+	ctx := context.Background()
+	long := testAction{Query: strings.Repeat("q", 100), Tags: []string{}}
+
+	if _, err := (&CallbackCodec[testAction]{Action: "search"}).Encode(ctx, long); !errors.Is(err, ErrCallbackDataTooLong) {
+		t.Errorf("Encode() without a store = %v, want ErrCallbackDataTooLong", err)
+	}
+
+	if _, err := (&CallbackCodec[testAction]{Action: strings.Repeat("a", 60), Store: &MemoryCallbackStore{}}).Encode(ctx, long); !errors.Is(err, ErrCallbackDataTooLong) {
+		t.Errorf("Encode() of a long action = %v, want ErrCallbackDataTooLong", err)
+	}
+
+	if _, err := (&CallbackCodec[testAction]{Action: "search", Store: failingStore{}}).Encode(ctx, long); err == nil {
+		t.Error("Encode() to a failing store did not fail")
+	}
+
+	store := &MemoryCallbackStore{TTL: time.Hour}
+	codec := &CallbackCodec[testAction]{Action: "search", Key: []byte("secret"), Store: store}
+
+	data, err := codec.Encode(ctx, long)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(data, "search:#") || len(data) > MaxCallbackDataLength {
+		t.Fatalf("Encode() = %q, want a stored payload", data)
+	}
+
+	if short, err := codec.Encode(ctx, testAction{Page: 1}); err != nil || strings.Contains(short, "#") || len(store.entries) != 1 {
+		t.Errorf("Encode() of a short value = %q, %v, want it in the callback data", short, err)
+	}
+
+	if value, err := codec.Decode(ctx, data); err != nil || !reflect.DeepEqual(value, long) {
+		t.Errorf("Decode(%q) = %+v, %v, want the stored value", data, value, err)
+	}
+
+	if _, err := codec.Decode(ctx, "search:#AAAAAAAAAAAA"); !errors.Is(err, ErrCallbackDataStale) {
+		t.Errorf("Decode() of a missing id = %v, want ErrCallbackDataStale", err)
+	}
+
+	entry := store.entries[data]
+	entry.expires = time.Now().Add(-time.Second)
+	store.entries[data] = entry
+
+	if _, err := codec.Decode(ctx, data); !errors.Is(err, ErrCallbackDataStale) {
+		t.Errorf("Decode() of an expired id = %v, want ErrCallbackDataStale", err)
+	}
+
+	failing := &CallbackCodec[testAction]{Action: "search", Store: failingStore{}}
+	if _, err := failing.Decode(ctx, data); err == nil || errors.Is(err, ErrCallbackDataStale) {
+		t.Errorf("Decode() from a failing store = %v, want the error of the store", err)
+	}
+}
+
+func TestMemoryCallbackStoreSweep(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryCallbackStore{TTL: time.Minute}
+
+	for _, id := range []string{"a", "b"} {
+		if err := store.Put(ctx, id, []byte(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for id, entry := range store.entries {
+		entry.expires = time.Now().Add(-time.Second)
+		store.entries[id] = entry
+	}
+
+	store.sweepAt = 0
+
+	if err := store.Put(ctx, "c", []byte("c")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.entries) != 1 {
+		t.Errorf("%d entries after sweeping, want 1", len(store.entries))
+	}
+
+	if payload, ok, err := store.Get(ctx, "c"); err != nil || !ok || string(payload) != "c" {
+		t.Errorf("Get(c) = %q, %v, %v", payload, ok, err)
+	}
+}
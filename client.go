@@ -0,0 +1,124 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultBaseURL is the base url of the Bot API.
+const DefaultBaseURL = "https://api.telegram.org"
+
+// Client sends the request structs to the Bot API on behalf of a bot.
+type Client struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a new Client of the bot with the given token. An empty baseURL means DefaultBaseURL and a nil httpClient means http.DefaultClient.
+func NewClient(token, baseURL string, httpClient *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		token:      token,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Execute sends the request of the method and returns its result.
+func Execute[R any](ctx context.Context, c *Client, method TypedMethod[R]) (R, error) {
+	result := method.NewResult()
+
+	err := c.Do(ctx, method, result)
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+
+	return *result, nil
+}
+
+// Do sends the request of the method and decodes its result into result, which must be a pointer or nil to discard the result.
+// A request implementing Validator is validated first. An unsuccessful request returns an *APIError.
+func (c *Client) Do(ctx context.Context, method Method, result interface{}) error {
+	if validator, ok := method.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	body, contentType, err := EncodeRequest(method)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.methodURL(method), body)
+	if err != nil {
+		if closer, ok := body.(io.Closer); ok {
+			closer.Close()
+		}
+
+		return c.redact(err)
+	}
+
+	request.Header.Set("Content-Type", contentType)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return c.redact(err)
+	}
+	defer response.Body.Close()
+
+	envelope := Response[json.RawMessage]{}
+
+	err = json.NewDecoder(response.Body).Decode(&envelope)
+	if err != nil {
+		return fmt.Errorf("telegram: decoding the response of %s (%s): %w", method.MethodName(), response.Status, err)
+	}
+
+	err = envelope.Err()
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(envelope.Result, result)
+	if err != nil {
+		return fmt.Errorf("telegram: decoding the result of %s: %w", method.MethodName(), err)
+	}
+
+	classifyResult(result)
+
+	return nil
+}
+
+// methodURL returns the url of the method.
+func (c *Client) methodURL(method Method) string {
+	return c.baseURL + "/bot" + c.token + "/" + method.MethodName()
+}
+
+// redact removes the token of the bot from the url of a request error, so it does not leak into logs.
+func (c *Client) redact(err error) error {
+	urlErr := &url.Error{}
+	if errors.As(err, &urlErr) {
+		urlErr.URL = strings.Replace(urlErr.URL, c.token, "<token>", -1)
+	}
+
+	return err
+}
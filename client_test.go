@@ -0,0 +1,163 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testToken = "123456:secret-token"
+
+// newTestClient returns a Client of a test server responding with the handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(testToken, server.URL+"/", server.Client())
+}
+
+func TestClientExecute(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/bot"+testToken+"/sendMessage" {
+			t.Errorf("request %s %s", r.Method, r.URL.Path)
+		}
+
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("Content-Type = %q", contentType)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"chat_id":"1","text":"hi"}` {
+			t.Errorf("body = %s", body)
+		}
+
+		io.WriteString(w, `{"ok":true,"result":{"message_id":5,"date":1,"chat":{"id":1,"type":"private"},"text":"hi"}}`)
+	})
+
+	message, err := Execute(context.Background(), client, SendMessage{ChatID: "1", Text: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.MessageID != 5 || message.Type != TextType {
+		t.Errorf("message = %+v", message)
+	}
+}
+
+func TestClientEditedMessage(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"ok":true,"result":true}`)
+	})
+
+	inlineMessageID := "inline"
+
+	edited, err := Execute(context.Background(), client, EditMessageText{InlineMessageID: &inlineMessageID, Text: "hi"})
+	if err != nil || edited.Message != nil {
+		t.Errorf("Execute() = %+v, %v, want an inline message", edited, err)
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	tests := []struct {
+		status     int
+		body       string
+		code       int64
+		retryAfter int64
+		migrate    int64
+	}{
+		{http.StatusTooManyRequests, `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 3","parameters":{"retry_after":3}}`, 429, 3, 0},
+		{http.StatusBadRequest, `{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-100123}}`, 400, 0, -100123},
+		{http.StatusForbidden, `{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`, 403, 0, 0},
+	}
+
+	for _, test := range tests {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+			io.WriteString(w, test.body)
+		})
+
+		_, err := Execute(context.Background(), client, GetChat{ChatID: "1"})
+
+		apiErr := &APIError{}
+		if !errors.As(err, &apiErr) {
+			t.Errorf("Execute() error = %v, want an *APIError", err)
+			continue
+		}
+
+		if apiErr.ErrorCode != test.code {
+			t.Errorf("ErrorCode = %d, want %d", apiErr.ErrorCode, test.code)
+		}
+
+		if (test.retryAfter != 0) != (apiErr.RetryAfter != nil) || (apiErr.RetryAfter != nil && *apiErr.RetryAfter != test.retryAfter) {
+			t.Errorf("RetryAfter = %v, want %d", apiErr.RetryAfter, test.retryAfter)
+		}
+
+		if (test.migrate != 0) != (apiErr.MigrateToChatID != nil) || (apiErr.MigrateToChatID != nil && *apiErr.MigrateToChatID != test.migrate) {
+			t.Errorf("MigrateToChatID = %v, want %d", apiErr.MigrateToChatID, test.migrate)
+		}
+	}
+}
+
+func TestClientInvalidResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		io.WriteString(w, "<html>Bad Gateway</html>")
+	})
+
+	_, err := Execute(context.Background(), client, GetMe{})
+	if err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("Execute() error = %v, want the status of the response", err)
+	}
+}
+
+func TestClientValidates(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	_, err := Execute(context.Background(), client, SendMessage{ChatID: "1"})
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("Execute() error = %v, want ErrInvalidRequest", err)
+	}
+
+	if requests != 0 {
+		t.Errorf("an invalid request was sent")
+	}
+}
+
+func TestClientRedactsToken(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := NewClient(testToken, server.URL, nil)
+
+	_, err := Execute(context.Background(), client, GetMe{})
+	if err == nil {
+		t.Fatal("Execute() of a closed server did not fail")
+	}
+
+	if strings.Contains(err.Error(), testToken) || !strings.Contains(err.Error(), "/bot<token>/getMe") {
+		t.Errorf("Execute() error = %v, want the token redacted", err)
+	}
+}
+
+func TestClientCanceled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Execute(ctx, client, GetMe{})
+	if !errors.Is(err, context.Canceled) || strings.Contains(err.Error(), testToken) {
+		t.Errorf("Execute() error = %v, want context.Canceled without the token", err)
+	}
+}
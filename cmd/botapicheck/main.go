@@ -0,0 +1,31 @@
+// Command botapicheck checks the structs of the telegram package against the Bot API schema, reporting their unknown,
+// missing, mistyped and misnamed fields. It exits with status 1 if it finds a problem. Run it with
+//
+//	go run ./cmd/botapicheck
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hellowearemito/go-telegram-structs/schema"
+)
+
+func main() {
+	s, err := schema.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	problems := s.Check(registry())
+
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d problems with Bot API %s\n", len(problems), s.Version)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hellowearemito/go-telegram-structs/schema"
+)
+
+func TestRegistryMatchesSchema(t *testing.T) {
+	s, err := schema.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, problem := range s.Check(registry()) {
+		t.Errorf("Bot API %s: %v", s.Version, problem)
+	}
+}
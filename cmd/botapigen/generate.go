@@ -0,0 +1,767 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/hellowearemito/go-telegram-structs/schema"
+)
+
+// generator generates the Go code of a schema.
+type generator struct {
+	schema   *schema.Schema
+	hints    *Hints
+	source   string          // Path of the schema, written in the header of the generated files
+	abstract map[string]bool // Names of the types with subtypes
+	warnings []string
+}
+
+// goStruct is a generated struct.
+type goStruct struct {
+	Name   string
+	Doc    string
+	Embed  string
+	Fields []goField
+}
+
+// goField is a field of a generated struct.
+type goField struct {
+	Name    string
+	Type    string
+	Tag     string
+	Comment string
+}
+
+// newGenerator returns a generator of the schema with the hints.
+func newGenerator(s *schema.Schema, hints *Hints, source string) *generator {
+	g := &generator{schema: s, hints: hints, source: source, abstract: map[string]bool{}}
+
+	for _, t := range s.Types {
+		if len(t.Subtypes) > 0 {
+			g.abstract[t.Name] = true
+		}
+	}
+
+	return g
+}
+
+// warn records a problem of the schema or the hints which does not stop the generation.
+func (g *generator) warn(format string, args ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, args...))
+}
+
+// files returns the generated files by path, relative to the directory of the package.
+func (g *generator) files() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	placed := map[string]bool{}
+
+	layout := append([]FileHints(nil), g.hints.Files...)
+
+	for _, file := range layout {
+		for _, name := range file.Objects {
+			placed[name] = true
+		}
+	}
+
+	newTypes, newMethods := FileHints{Name: "new_types.go"}, FileHints{Name: "new_methods.go"}
+
+	for _, t := range g.schema.Types {
+		if !placed[t.Name] && !g.hints.Types[t.Name].Skip {
+			newTypes.Objects = append(newTypes.Objects, t.Name)
+			g.warn("type %s is not in the files of the hints, generated into %s", t.Name, newTypes.Name)
+		}
+	}
+
+	for _, m := range g.schema.Methods {
+		if !placed[m.Name] {
+			newMethods.Objects = append(newMethods.Objects, m.Name)
+			g.warn("method %s is not in the files of the hints, generated into %s", m.Name, newMethods.Name)
+		}
+	}
+
+	for _, file := range []FileHints{newTypes, newMethods} {
+		if len(file.Objects) > 0 {
+			layout = append(layout, file)
+		}
+	}
+
+	for _, file := range layout {
+		src, err := g.format(file.Name, g.file(file.Objects))
+		if err != nil {
+			return nil, err
+		}
+
+		files[file.Name] = src
+	}
+
+	for name, body := range map[string]string{
+		"api_version.go":              g.versions(),
+		"method_metadata.go":          g.metadata(),
+		"method_decoding.go":          g.decoding(),
+		"method_validation.go":        g.validation(),
+		"cmd/botapicheck/registry.go": g.registry(),
+	} {
+		src, err := g.format(name, body)
+		if err != nil {
+			return nil, err
+		}
+
+		files[name] = src
+	}
+
+	return files, nil
+}
+
+// format adds the header to the body of a generated file and formats it.
+func (g *generator) format(name, body string) ([]byte, error) {
+	pkg := "telegram"
+	if strings.HasPrefix(name, "cmd/") {
+		pkg = "main"
+	}
+
+	header := fmt.Sprintf("// Code generated by botapigen from %s. DO NOT EDIT.\n\npackage %s\n\n", g.source, pkg)
+
+	for _, pkgImport := range []struct{ name, path string }{{"json.", "encoding/json"}, {"regexp.", "regexp"}} {
+		if pkg == "telegram" && strings.Contains(body, pkgImport.name) {
+			header += fmt.Sprintf("import %q\n\n", pkgImport.path)
+		}
+	}
+
+	src, err := format.Source([]byte(header + body))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return src, nil
+}
+
+// file returns the body of a file of types, mixins and methods.
+func (g *generator) file(objects []string) string {
+	var b strings.Builder
+
+	for _, name := range objects {
+		g.writeConstants(&b, name)
+
+		if mixin, ok := g.hints.Mixins[name]; ok {
+			writeStruct(&b, g.mixin(name, mixin))
+			continue
+		}
+
+		if t := g.schema.Type(name); t != nil {
+			if g.hints.Types[name].Skip {
+				continue
+			}
+
+			if len(t.Subtypes) > 0 {
+				fmt.Fprintf(&b, "// %s\ntype %s interface{}\n\n", g.abstractDoc(t), g.typeName(name))
+				continue
+			}
+
+			writeStruct(&b, g.typeStruct(t))
+			continue
+		}
+
+		if m := g.schema.Method(name); m != nil {
+			writeStruct(&b, g.methodStruct(m))
+			continue
+		}
+
+		g.warn("%s of the files of the hints is not in the schema", name)
+	}
+
+	return b.String()
+}
+
+// writeStruct writes the declaration of the struct.
+func writeStruct(b *strings.Builder, s goStruct) {
+	fmt.Fprintf(b, "// %s\n", s.Doc)
+
+	if s.Embed == "" && len(s.Fields) == 0 {
+		fmt.Fprintf(b, "type %s struct{}\n\n", s.Name)
+		return
+	}
+
+	fmt.Fprintf(b, "type %s struct {\n", s.Name)
+
+	if s.Embed != "" {
+		fmt.Fprintf(b, "\t%s\n", s.Embed)
+	}
+
+	for _, f := range s.Fields {
+		fmt.Fprintf(b, "\t%s %s `json:%q` // %s\n", f.Name, f.Type, f.Tag, f.Comment)
+	}
+
+	b.WriteString("}\n\n")
+}
+
+// writeConstants writes the constants of the values of the fields of the object.
+func (g *generator) writeConstants(b *strings.Builder, object string) {
+	for _, c := range g.hints.Constants {
+		owner, name, _ := strings.Cut(c.Field, ".")
+		if owner != object {
+			continue
+		}
+
+		field := g.field(owner, name)
+		if field == nil || len(field.Values) == 0 {
+			g.warn("constants of %s: the field has no values", c.Field)
+			continue
+		}
+
+		b.WriteString("const (\n")
+
+		for _, value := range field.Values {
+			constName := c.Names[value]
+			if constName == "" {
+				constName = goName(value)
+			}
+
+			fmt.Fprintf(b, "\t// %s %s.\n", constName, strings.ReplaceAll(c.Doc, "%s", value))
+
+			if c.Type != "" {
+				fmt.Fprintf(b, "\t%s %s = %q\n", constName, c.Type, value)
+			} else {
+				fmt.Fprintf(b, "\t%s = %q\n", constName, value)
+			}
+		}
+
+		b.WriteString(")\n\n")
+	}
+}
+
+// field returns the field of the type or method, or nil.
+func (g *generator) field(object, name string) *schema.Field {
+	if t := g.schema.Type(object); t != nil {
+		return t.Field(name)
+	}
+
+	if m := g.schema.Method(object); m != nil {
+		return m.Field(name)
+	}
+
+	return nil
+}
+
+// typeName returns the Go name of a type.
+func (g *generator) typeName(name string) string {
+	if hint := g.hints.Types[name]; hint.Name != "" {
+		return hint.Name
+	}
+
+	return name
+}
+
+// methodName returns the Go name of the request struct of a method.
+func methodName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// typeStruct returns the struct of a type, without the fields of its mixin.
+func (g *generator) typeStruct(t *schema.Type) goStruct {
+	hint := g.hints.Types[t.Name]
+	name := g.typeName(t.Name)
+	embedded := g.mixinFields(hint.Embed)
+
+	s := goStruct{Name: name, Doc: typeDoc(name, t.Description), Embed: hint.Embed}
+
+	for _, extra := range hint.Extra {
+		if extra.After == "" {
+			s.Fields = append(s.Fields, goField{Name: extra.Name, Type: extra.Type, Tag: extra.Tag, Comment: extra.Comment})
+		}
+	}
+
+	for _, f := range t.Fields {
+		if !embedded[f.Name] {
+			s.Fields = append(s.Fields, g.goField(t.Name, f, f.Required))
+		}
+
+		for _, extra := range hint.Extra {
+			if extra.After == f.Name {
+				s.Fields = append(s.Fields, goField{Name: extra.Name, Type: extra.Type, Tag: extra.Tag, Comment: extra.Comment})
+			}
+		}
+	}
+
+	return s
+}
+
+// methodStruct returns the request struct of a method.
+func (g *generator) methodStruct(m *schema.Method) goStruct {
+	name := methodName(m.Name)
+	s := goStruct{Name: name, Doc: name + " : " + m.Description}
+
+	for _, f := range m.Fields {
+		s.Fields = append(s.Fields, g.goField(m.Name, f, f.Required))
+	}
+
+	return s
+}
+
+// mixin returns the struct of a mixin. Its fields are described as in the first type embedding it, and are required only
+// if every type embedding it requires them.
+func (g *generator) mixin(name string, hint MixinHints) goStruct {
+	s := goStruct{Name: name, Doc: hint.Doc, Embed: hint.Embed}
+
+	for _, fieldName := range hint.Fields {
+		var field *schema.Field
+		required := true
+
+		for _, t := range g.schema.Types {
+			if !g.mixinFields(g.hints.Types[t.Name].Embed)[fieldName] || !g.embeds(t.Name, name) {
+				continue
+			}
+
+			f := t.Field(fieldName)
+			if f == nil {
+				continue
+			}
+
+			if field == nil {
+				field = f
+			}
+
+			required = required && f.Required
+		}
+
+		if field == nil {
+			g.warn("mixin %s: no type embedding it has the field %s", name, fieldName)
+			continue
+		}
+
+		s.Fields = append(s.Fields, g.goField(name, field, required))
+	}
+
+	return s
+}
+
+// embeds reports whether the type embeds the mixin, directly or through another mixin.
+func (g *generator) embeds(typeName, mixin string) bool {
+	for embed := g.hints.Types[typeName].Embed; embed != ""; embed = g.hints.Mixins[embed].Embed {
+		if embed == mixin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mixinFields returns the names of the fields of the mixin and of the mixins it embeds.
+func (g *generator) mixinFields(mixin string) map[string]bool {
+	fields := map[string]bool{}
+
+	for ; mixin != ""; mixin = g.hints.Mixins[mixin].Embed {
+		for _, name := range g.hints.Mixins[mixin].Fields {
+			fields[name] = true
+		}
+	}
+
+	return fields
+}
+
+// goField returns the Go field of a field of the object.
+func (g *generator) goField(object string, f *schema.Field, required bool) goField {
+	hint := g.hints.Fields[object+"."+f.Name]
+
+	field := goField{Name: hint.Name, Type: hint.Type, Tag: f.Name, Comment: f.Description}
+
+	if field.Name == "" {
+		field.Name = goName(f.Name)
+	}
+
+	if field.Type == "" {
+		field.Type = g.goType(object, f.Types)
+
+		if !required && !strings.HasPrefix(field.Type, "[]") && !g.hints.isInterface(field.Type) && !g.abstract[field.Type] {
+			field.Type = "*" + field.Type
+		}
+	}
+
+	if !required {
+		omit := hint.Omit
+		if omit == "" {
+			omit = "omitempty"
+		}
+
+		field.Tag += "," + omit
+	}
+
+	if hint.Note != "" {
+		field.Comment += " " + hint.Note
+	}
+
+	return field
+}
+
+// goType returns the Go type of the types of a field of the object.
+func (g *generator) goType(object string, types []string) string {
+	union := strings.Join(types, " or ")
+
+	if goType, ok := g.hints.GoTypes[union]; ok {
+		return goType
+	}
+
+	if len(types) == 1 {
+		return g.baseType(types[0])
+	}
+
+	g.warn("%s: no Go type of %s in the go_types of the hints", object, union)
+
+	return "interface{}"
+}
+
+// baseType returns the Go type of a single type.
+func (g *generator) baseType(name string) string {
+	if elem, ok := strings.CutPrefix(name, "Array of "); ok {
+		return "[]" + g.baseType(elem)
+	}
+
+	switch name {
+	case "Integer":
+		return "int64"
+	case "Float":
+		return "float64"
+	case "String":
+		return "string"
+	case "Boolean", "True":
+		return "bool"
+	}
+
+	if goType, ok := g.hints.GoTypes[name]; ok {
+		return goType
+	}
+
+	if g.schema.Type(name) == nil {
+		g.warn("type %s is not in the schema", name)
+	}
+
+	return g.typeName(name)
+}
+
+// resultType returns the Go type of the result of a method.
+func (g *generator) resultType(m *schema.Method) string {
+	return g.goType(m.Name, m.Returns)
+}
+
+// typeDoc returns the doc comment of a type, e.g. "Chat represents a chat." for "This object represents a chat.".
+func typeDoc(name, description string) string {
+	for _, verb := range []string{"represents", "contains", "describes"} {
+		if rest, ok := strings.CutPrefix(description, "This object "+verb+" "); ok {
+			return name + " " + verb + " " + rest
+		}
+	}
+
+	return name + " : " + description
+}
+
+// abstractDoc returns the doc comment of an abstract type, listing its subtypes.
+func (g *generator) abstractDoc(t *schema.Type) string {
+	subtypes := []string{}
+	for _, name := range t.Subtypes {
+		subtypes = append(subtypes, g.typeName(name))
+	}
+
+	list := subtypes[0]
+	if len(subtypes) > 1 {
+		list = strings.Join(subtypes[:len(subtypes)-1], ", ") + " or " + subtypes[len(subtypes)-1]
+	}
+
+	return typeDoc(g.typeName(t.Name), t.Description) + " It should be one of " + list + ", or a pointer to them."
+}
+
+// metadata returns the body of the file of the names and the result types of the methods.
+func (g *generator) metadata() string {
+	var b strings.Builder
+
+	b.WriteString("// The request structs with their result types.\nvar (\n")
+
+	for _, m := range g.schema.Methods {
+		fmt.Fprintf(&b, "\t_ TypedMethod[%s] = %s{}\n", g.resultType(m), methodName(m.Name))
+	}
+
+	b.WriteString(")\n")
+
+	for _, m := range g.schema.Methods {
+		name, result := methodName(m.Name), g.resultType(m)
+
+		fmt.Fprintf(&b, "\n// MethodName returns %s.\nfunc (%s) MethodName() string { return %q }\n", m.Name, name, m.Name)
+		fmt.Fprintf(&b, "\n// NewResult returns a new %s to decode the result into.\nfunc (%s) NewResult() *%s { return new(%s) }\n", result, name, result, result)
+	}
+
+	return b.String()
+}
+
+// decoding returns the body of the file of the UnmarshalJSON methods of the request structs with a ReplyMarkup, which
+// decode it by UnmarshalReplyMarkup, e.g. to replay logged requests.
+func (g *generator) decoding() string {
+	var b strings.Builder
+
+	for _, m := range g.schema.Methods {
+		for _, f := range m.Fields {
+			field := g.goField(m.Name, f, f.Required)
+			if field.Type != "ReplyMarkup" {
+				continue
+			}
+
+			name := methodName(m.Name)
+
+			fmt.Fprintf(&b, "// UnmarshalJSON decodes the request, with its %s decoded by UnmarshalReplyMarkup.\n", field.Name)
+			fmt.Fprintf(&b, "func (m *%s) UnmarshalJSON(data []byte) error {\n\ttype request %s\n\n", name, name)
+			fmt.Fprintf(&b, "\tshadow := struct {\n\t\t*request\n\t\t%s json.RawMessage `json:%q`\n\t}{request: (*request)(m)}\n\n", field.Name, f.Name)
+			b.WriteString("\tif err := json.Unmarshal(data, &shadow); err != nil {\n\t\treturn err\n\t}\n\n")
+			fmt.Fprintf(&b, "\tmarkup, err := UnmarshalReplyMarkup(shadow.%s)\n\tm.%s = markup\n\n\treturn err\n}\n\n", field.Name, field.Name)
+		}
+	}
+
+	return b.String()
+}
+
+// versions returns the body of the file of the version of the Bot API and the versions which added the structs and
+// their fields. A field without a version is as old as its type or method.
+func (g *generator) versions() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "// BotAPIVersion is the version of the Bot API the structs are generated from.\nconst BotAPIVersion = %q\n\n", g.schema.Version)
+	b.WriteString("// structVersions are the versions of the Bot API which added the structs and their fields.\nvar structVersions = []StructVersion{\n")
+
+	for _, t := range g.schema.Types {
+		if len(t.Subtypes) == 0 && !g.hints.Types[t.Name].Skip {
+			g.writeVersion(&b, g.typeName(t.Name), t.Name, t.Since, t.Fields)
+		}
+	}
+
+	for _, m := range g.schema.Methods {
+		g.writeVersion(&b, methodName(m.Name), m.Name, m.Since, m.Fields)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// writeVersion writes the versions of the struct of a type or method and of its fields.
+func (g *generator) writeVersion(b *strings.Builder, name, object, since string, fields []*schema.Field) {
+	fmt.Fprintf(b, "\t{Name: %q, Object: %q%s, Fields: []FieldVersion{\n", name, object, sinceField(since))
+
+	for _, f := range fields {
+		fieldSince := f.Since
+		if fieldSince == "" {
+			fieldSince = since
+		}
+
+		fmt.Fprintf(b, "\t\t{Name: %q, JSON: %q%s},\n", g.goField(object, f, f.Required).Name, f.Name, sinceField(fieldSince))
+	}
+
+	b.WriteString("\t}},\n")
+}
+
+// sinceField returns the Since field of a composite literal of the version, empty for the versions older than Bot API 2.0.
+func sinceField(since string) string {
+	if since == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", Since: %q", since)
+}
+
+// validation returns the body of the file of the Validate methods of the request structs. They check that the required
+// strings and interfaces are set, the limits of the parameters, the values of the parameters having values, the reply
+// markups, and that the methods addressing a message either by chat_id and message_id or by inline_message_id set
+// exactly one of the two forms.
+func (g *generator) validation() string {
+	var b, patterns strings.Builder
+
+	for _, m := range g.schema.Methods {
+		var checks strings.Builder
+
+		for _, f := range m.Fields {
+			block := g.checks(&patterns, m, f)
+
+			// The checks of the optional parameters are blocks separated by blank lines.
+			if checks.Len() > 0 && (strings.HasPrefix(block, "\tif") || strings.HasSuffix(checks.String(), "}\n")) {
+				checks.WriteString("\n")
+			}
+
+			checks.WriteString(block)
+		}
+
+		chatID, messageID, inlineMessageID := m.Field("chat_id"), m.Field("message_id"), m.Field("inline_message_id")
+		if chatID != nil && messageID != nil && inlineMessageID != nil && !chatID.Required && !messageID.Required && !inlineMessageID.Required {
+			if checks.Len() > 0 {
+				checks.WriteString("\n")
+			}
+
+			fmt.Fprintf(&checks, "\tv.messageAddress(m.%s != nil, m.%s != nil, m.%s != nil)\n",
+				g.goField(m.Name, chatID, false).Name, g.goField(m.Name, messageID, false).Name, g.goField(m.Name, inlineMessageID, false).Name)
+		}
+
+		name := methodName(m.Name)
+
+		b.WriteString("// Validate checks the request against the constraints of the Bot API.\n")
+
+		if checks.Len() == 0 {
+			fmt.Fprintf(&b, "func (%s) Validate() error {\n\treturn nil\n}\n\n", name)
+			continue
+		}
+
+		fmt.Fprintf(&b, "func (m %s) Validate() error {\n\tv := newValidation(%q)\n\n%s\n\treturn v.result()\n}\n\n", name, m.Name, checks.String())
+	}
+
+	if patterns.Len() > 0 {
+		return "// The patterns of the parameters.\nvar (\n" + patterns.String() + ")\n\n" + b.String()
+	}
+
+	return b.String()
+}
+
+// checks returns the checks of a parameter of the method, and writes the pattern it must match.
+func (g *generator) checks(patterns *strings.Builder, m *schema.Method, f *schema.Field) string {
+	field := g.goField(m.Name, f, f.Required)
+	goType := strings.TrimPrefix(field.Type, "*")
+	value := "m." + field.Name
+	pointer := strings.HasPrefix(field.Type, "*")
+
+	if pointer {
+		value = "*" + value
+	}
+
+	limits := f.Limits
+	if limits == nil {
+		limits = &schema.Limits{}
+	}
+
+	checks := []string{}
+	check := func(format string, args ...interface{}) {
+		checks = append(checks, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case goType == "string":
+		switch {
+		case limits.MinLength > 0 || limits.MaxLength > 0:
+			if parseMode := m.Field("parse_mode"); parseMode != nil && f.Name != "parse_mode" {
+				check("v.formattedLength(%q, %s, m.%s, %d, %d)", f.Name, value, g.goField(m.Name, parseMode, parseMode.Required).Name, limits.MinLength, limits.MaxLength)
+			} else {
+				check("v.length(%q, %s, %d, %d)", f.Name, value, limits.MinLength, limits.MaxLength)
+			}
+		case limits.MinBytes > 0 || limits.MaxBytes > 0:
+		case f.Required && !limits.AllowEmpty:
+			check("v.required(%q, %s != \"\")", f.Name, value)
+		}
+
+		if limits.MinBytes > 0 || limits.MaxBytes > 0 {
+			check("v.bytes(%q, %s, %d, %d)", f.Name, value, limits.MinBytes, limits.MaxBytes)
+		}
+
+		if limits.Pattern != "" {
+			pattern := strings.ToLower(m.Name[:1]) + m.Name[1:] + field.Name + "Pattern"
+			fmt.Fprintf(patterns, "\t%s = regexp.MustCompile(`%s`)\n", pattern, limits.Pattern)
+			check("v.matches(%q, %s, %s, %q)", f.Name, value, pattern, limits.Format)
+		}
+
+		if len(f.Values) > 0 {
+			values := []string{}
+			for _, v := range f.Values {
+				values = append(values, fmt.Sprintf("%q", v))
+			}
+
+			check("v.oneOf(%q, %s, %s)", f.Name, value, strings.Join(values, ", "))
+		}
+	case goType == "int64" || goType == "float64":
+		number := value
+		if goType == "int64" {
+			number = "float64(" + value + ")"
+		}
+
+		switch {
+		case limits.Min != nil && limits.Max != nil:
+			check("v.between(%q, %s, %g, %g)", f.Name, number, *limits.Min, *limits.Max)
+		case limits.Min != nil:
+			check("v.atLeast(%q, %s, %g)", f.Name, number, *limits.Min)
+		case limits.Max != nil:
+			check("v.atMost(%q, %s, %g)", f.Name, number, *limits.Max)
+		}
+	case goType == "bool":
+		if limits.Implies != "" {
+			if other := m.Field(limits.Implies); other != nil {
+				check("v.implies(%q, m.%s, %q, m.%s)", f.Name, field.Name, other.Name, g.goField(m.Name, other, other.Required).Name)
+			}
+		}
+
+		// The implication compares the pointers themselves.
+		pointer = false
+	case goType == "ReplyMarkup" || goType == "InlineKeyboardMarkup":
+		check("v.markup(%q, %s)", f.Name, value)
+	case strings.HasPrefix(goType, "[]"):
+		if limits.MinItems > 0 || limits.MaxItems > 0 {
+			check("v.items(%q, int64(len(%s)), %d, %d)", f.Name, value, limits.MinItems, limits.MaxItems)
+		}
+	case g.hints.isInterface(goType) || g.abstract[goType]:
+		if f.Required {
+			check("v.required(%q, %s != nil)", f.Name, value)
+		}
+	}
+
+	if len(checks) == 0 {
+		return ""
+	}
+
+	if pointer {
+		return fmt.Sprintf("\tif m.%s != nil {\n\t\t%s\n\t}\n", field.Name, strings.Join(checks, "\n\t\t"))
+	}
+
+	return "\t" + strings.Join(checks, "\n\t") + "\n"
+}
+
+// registry returns the body of the registry of the Go types of botapicheck.
+func (g *generator) registry() string {
+	var b strings.Builder
+
+	b.WriteString("import (\n\t\"reflect\"\n\n\ttelegram \"github.com/hellowearemito/go-telegram-structs\"\n\t\"github.com/hellowearemito/go-telegram-structs/schema\"\n)\n\n")
+	b.WriteString("// methods are the request structs of the telegram package, in the order of the schema.\nvar methods = []telegram.Method{\n")
+
+	for _, m := range g.schema.Methods {
+		fmt.Fprintf(&b, "\ttelegram.%s{},\n", methodName(m.Name))
+	}
+
+	b.WriteString("}\n\n// registry returns the Go types of the types and methods of the schema.\nfunc registry() schema.Registry {\n")
+	b.WriteString("\tr := schema.Registry{\n\t\tTypes: map[string]reflect.Type{\n")
+
+	for _, t := range g.schema.Types {
+		if g.hints.Types[t.Name].Skip {
+			fmt.Fprintf(&b, "\t\t\t// %s has no Go type, so any type can be used for it.\n", t.Name)
+			fmt.Fprintf(&b, "\t\t\t%q: reflect.TypeOf((*interface{})(nil)).Elem(),\n", t.Name)
+			continue
+		}
+
+		b.WriteString(g.reflectType(t.Name, g.typeName(t.Name)))
+	}
+
+	names := make([]string, 0, len(g.hints.GoTypes))
+	for name := range g.hints.GoTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if goType := g.hints.GoTypes[name]; goType[0] >= 'A' && goType[0] <= 'Z' {
+			b.WriteString(g.reflectType(name, goType))
+		}
+	}
+
+	b.WriteString("\t\t},\n\t\tMethods: map[string]reflect.Type{},\n\t}\n\n")
+	b.WriteString("\tfor _, method := range methods {\n\t\tr.Methods[method.MethodName()] = reflect.TypeOf(method)\n\t}\n\n\treturn r\n}\n")
+
+	return b.String()
+}
+
+// reflectType returns the entry of the registry of the Go type of the name.
+func (g *generator) reflectType(name, goType string) string {
+	if g.hints.isInterface(goType) || g.abstract[name] {
+		return fmt.Sprintf("\t\t\t%q: reflect.TypeOf((*telegram.%s)(nil)).Elem(),\n", name, goType)
+	}
+
+	return fmt.Sprintf("\t\t\t%q: reflect.TypeOf(telegram.%s{}),\n", name, goType)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Hints are the details of the generated Go code which the Bot API reference does not tell.
+type Hints struct {
+	Files      []FileHints           `json:"files"`      // Generated files, with the types, mixins and methods they hold, in order
+	Types      map[string]TypeHints  `json:"types"`      // Hints of the types, by name
+	Fields     map[string]FieldHints `json:"fields"`     // Hints of the fields, by type or method and field name, e.g. InlineQueryResultGif.gif_url
+	Mixins     map[string]MixinHints `json:"mixins"`     // Structs embedded by several types, by name
+	GoTypes    map[string]string     `json:"go_types"`   // Go types of the types and unions of types without a Go struct, e.g. "Integer or String": "string"
+	Interfaces []string              `json:"interfaces"` // Go types which are interfaces, so they are not pointers when optional
+	Constants  []ConstantHints       `json:"constants"`  // Constants of the values of the fields
+}
+
+// FileHints is a generated file.
+type FileHints struct {
+	Name    string   `json:"name"`    // Name of the file, e.g. chat_types.go
+	Objects []string `json:"objects"` // Names of the types, mixins and methods of the file, in order
+}
+
+// TypeHints are the hints of a type.
+type TypeHints struct {
+	Name  string       `json:"name,omitempty"`  // Optional. Go name of the type, if it is not the name of the type
+	Embed string       `json:"embed,omitempty"` // Optional. Mixin embedded by the type, in place of its fields
+	Skip  bool         `json:"skip,omitempty"`  // Optional. True, if no Go type is generated for the type
+	Extra []ExtraField `json:"extra,omitempty"` // Optional. Go fields which are not in the Bot API
+}
+
+// ExtraField is a Go field which is not in the Bot API.
+type ExtraField struct {
+	After   string `json:"after"`   // Name of the field after which the extra field comes
+	Name    string `json:"name"`    // Go name of the field
+	Type    string `json:"type"`    // Go type of the field
+	Tag     string `json:"tag"`     // Json tag of the field, e.g. - to leave it out of the JSON
+	Comment string `json:"comment"` // Comment of the field
+}
+
+// FieldHints are the hints of a field.
+type FieldHints struct {
+	Name string `json:"name,omitempty"` // Optional. Go name of the field, if it is not derived from its name
+	Type string `json:"type,omitempty"` // Optional. Go type of the field, if it is not derived from its types
+	Omit string `json:"omit,omitempty"` // Optional. Json option leaving out the unset field, if it is not omitempty, e.g. omitzero
+	Note string `json:"note,omitempty"` // Optional. Note appended to the description of the field
+}
+
+// MixinHints are the hints of a struct embedded by several types.
+type MixinHints struct {
+	Doc    string   `json:"doc"`             // Doc comment of the mixin
+	Embed  string   `json:"embed,omitempty"` // Optional. Mixin embedded by the mixin
+	Fields []string `json:"fields"`          // Names of the fields of the mixin
+}
+
+// ConstantHints are the hints of the constants of the values of a field.
+type ConstantHints struct {
+	Field string            `json:"field"`           // Type or method and name of the field, e.g. Chat.type
+	Doc   string            `json:"doc"`             // Doc comment of the constants, after their name, with %s replaced by the value, e.g. "is a constant of %s chat type"
+	Type  string            `json:"type,omitempty"`  // Optional. Go type of the constants, untyped if empty
+	Names map[string]string `json:"names,omitempty"` // Optional. Go names of the values, if they are not derived from them
+}
+
+// loadHints reads the hints from the file.
+func loadHints(path string) (*Hints, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h := new(Hints)
+
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return h, nil
+}
+
+// isInterface reports whether the Go type is an interface.
+func (h *Hints) isInterface(goType string) bool {
+	for _, name := range h.Interfaces {
+		if name == goType {
+			return true
+		}
+	}
+
+	return goType == "interface{}"
+}
+
+// goName returns the Go name of a json name or a value, e.g. ChatID for chat_id. The words id and url are written in
+// upper case.
+func goName(name string) string {
+	var b strings.Builder
+
+	for _, word := range strings.Split(name, "_") {
+		switch word {
+		case "id", "url":
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+
+		for i, r := range word {
+			if i == 0 {
+				r = unicode.ToUpper(r)
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,121 @@
+// Command botapigen generates the structs of the telegram package from the Bot API schema: the type and request
+// structs with their doc comments, the constants of the values of their fields, the names and result types of the
+// methods, the validation of the requests against the limits of the schema, the decoding of the reply markups of the
+// requests, the versions of the Bot API which added the structs and fields, and the registry of botapicheck. The details the Bot API reference does not tell, e.g. the files
+// of the structs and the Go names and types differing from the documentation, are read from schema/gohints.json.
+//
+// To support a new version of the Bot API, drop a snapshot of its reference, the HTML page of
+// https://core.telegram.org/bots/api or a JSON schema, next to the package and regenerate:
+//
+//	go run ./cmd/botapigen -snapshot api.html
+//
+// The added, removed and changed types, methods and fields are reported, the schema is rewritten from the snapshot and
+// the code is generated from it. Run go generate to regenerate the code after editing the schema or the hints.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hellowearemito/go-telegram-structs/schema"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "schema/botapi.json", "path of the Bot API schema")
+	hintsPath := flag.String("hints", "schema/gohints.json", "path of the hints of the generated Go code")
+	snapshot := flag.String("snapshot", "", "path of a snapshot of the Bot API reference, HTML or JSON, to import into the schema")
+	dir := flag.String("dir", ".", "directory of the telegram package")
+	flag.Parse()
+
+	if err := run(*schemaPath, *hintsPath, *snapshot, *dir); err != nil {
+		fmt.Fprintln(os.Stderr, "botapigen:", err)
+		os.Exit(1)
+	}
+}
+
+// run imports the snapshot into the schema, if any, and generates the code of the schema into the directory.
+func run(schemaPath, hintsPath, snapshot, dir string) error {
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	s, err := schema.Parse(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", schemaPath, err)
+	}
+
+	if snapshot != "" {
+		if s, err = importSnapshot(s, schemaPath, snapshot); err != nil {
+			return err
+		}
+	}
+
+	hints, err := loadHints(hintsPath)
+	if err != nil {
+		return err
+	}
+
+	g := newGenerator(s, hints, filepath.ToSlash(schemaPath))
+
+	files, err := g.files()
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range g.warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+
+	for name, src := range files {
+		if err := writeFile(filepath.Join(dir, name), src); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importSnapshot prints the changes of the snapshot to the current schema and rewrites the schema from it.
+func importSnapshot(current *schema.Schema, schemaPath, snapshot string) (*schema.Schema, error) {
+	data, err := os.ReadFile(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := schema.ParseSnapshot(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", snapshot, err)
+	}
+
+	next = schema.Merge(current, next)
+
+	fmt.Printf("Bot API %s -> %s\n", current.Version, next.Version)
+
+	for _, change := range schema.Diff(current, next) {
+		fmt.Println(change)
+	}
+
+	encoded, err := next.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeFile(schemaPath, encoded); err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
+
+// writeFile writes the file if its content changed, so that the untouched files keep their modification time.
+func writeFile(path string, data []byte) error {
+	if old, err := os.ReadFile(path); err == nil && bytes.Equal(old, data) {
+		return nil
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
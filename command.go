@@ -0,0 +1,182 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrUnterminatedQuote is returned by SplitArgs if a quote of the arguments is not closed.
+var ErrUnterminatedQuote = errors.New("telegram: unterminated quote in the command arguments")
+
+// Command is a bot command sent in a message, e.g. /start@jobs_bot welcome.
+type Command struct {
+	Name string // Name of the command without the slash, e.g. start
+	Bot  string // Optional. Username of the bot the command is addressed to, without the @
+	Args string // Text after the command, without the surrounding spaces
+}
+
+// ParseCommand returns the bot_command entity at the start of the text or the caption of the message. It returns false if
+// the message does not start with a command, e.g. "please run /start now", or the command is addressed to another bot than
+// the one with the given username, as returned by getMe.
+func ParseCommand(message *Message, username string) (*Command, bool) {
+	text, entities := message.Text, message.Entities
+	if text == nil {
+		text, entities = message.Caption, message.CaptionEntities
+	}
+
+	if text == nil {
+		return nil, false
+	}
+
+	for _, entity := range entities {
+		if entity.Type != BotCommand || entity.Offset != 0 {
+			continue
+		}
+
+		start, end, ok := utf16Range(*text, entity.Offset, entity.Length)
+		if !ok {
+			return nil, false
+		}
+
+		command := &Command{
+			Name: strings.TrimPrefix((*text)[start:end], "/"),
+			Args: strings.TrimSpace((*text)[end:]),
+		}
+
+		if i := strings.Index(command.Name, "@"); i >= 0 {
+			command.Name, command.Bot = command.Name[:i], command.Name[i+1:]
+		}
+
+		if command.Bot != "" && !strings.EqualFold(command.Bot, strings.TrimPrefix(username, "@")) {
+			return nil, false
+		}
+
+		return command, true
+	}
+
+	return nil, false
+}
+
+// SplitArgs splits the arguments of a command at the spaces. Spaces can be kept in an argument by quoting it with double
+// or single quotes, or by escaping them with a backslash, which also escapes quotes and backslashes outside single quotes.
+func SplitArgs(args string) ([]string, error) {
+	result := []string{}
+	current := strings.Builder{}
+	inArg, escaped := false, false
+	quote := rune(0)
+
+	for _, r := range args {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			inArg, escaped = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			inArg, quote = true, r
+		case unicode.IsSpace(r):
+			if inArg {
+				result = append(result, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			inArg = true
+			current.WriteRune(r)
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrUnterminatedQuote
+	}
+
+	if inArg {
+		result = append(result, current.String())
+	}
+
+	return result, nil
+}
+
+// CommandHandler responds to a bot command.
+type CommandHandler func(ctx context.Context, message *Message, command *Command) error
+
+// commandEntry is a registered command of a CommandRegistry.
+type commandEntry struct {
+	name        string
+	description string
+	handler     CommandHandler
+}
+
+// CommandRegistry passes the bot commands to their registered handlers. It can be registered to a Router with
+// router.OnMessage(registry.Filter(), registry.HandleMessage). The commands must be registered before handling messages.
+type CommandRegistry struct {
+	Username string // Username of the bot, as returned by getMe, to ignore the commands addressed to other bots
+	commands []commandEntry
+}
+
+// Register registers the handler of the command with the given name, without the slash, and its description for the help text.
+func (r *CommandRegistry) Register(name, description string, handler CommandHandler) {
+	r.commands = append(r.commands, commandEntry{
+		name:        strings.TrimPrefix(name, "/"),
+		description: description,
+		handler:     handler,
+	})
+}
+
+// Filter matches the messages with a registered command.
+func (r *CommandRegistry) Filter() MessageFilter {
+	return func(message *Message) bool {
+		_, _, ok := r.lookup(message)
+		return ok
+	}
+}
+
+// HandleMessage passes the message to the handler of its command. Messages without a registered command are ignored.
+func (r *CommandRegistry) HandleMessage(ctx context.Context, message *Message) error {
+	entry, command, ok := r.lookup(message)
+	if !ok {
+		return nil
+	}
+
+	return entry.handler(ctx, message, command)
+}
+
+// Help returns the list of the registered commands with their descriptions, one per line, e.g. "/start - Starts the bot".
+func (r *CommandRegistry) Help() string {
+	lines := make([]string, 0, len(r.commands))
+
+	for _, entry := range r.commands {
+		line := "/" + entry.name
+		if entry.description != "" {
+			line += " - " + entry.description
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// lookup returns the registered command of the message.
+func (r *CommandRegistry) lookup(message *Message) (commandEntry, *Command, bool) {
+	command, ok := ParseCommand(message, r.Username)
+	if !ok {
+		return commandEntry{}, nil, false
+	}
+
+	for _, entry := range r.commands {
+		if strings.EqualFold(entry.name, command.Name) {
+			return entry, command, true
+		}
+	}
+
+	return commandEntry{}, nil, false
+}
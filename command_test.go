@@ -0,0 +1,127 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// commandMessage returns a message with the text and a bot_command entity at the offset and of the length, in UTF-16 code units.
+func commandMessage(text string, offset, length int64) *Message {
+	return &Message{Text: &text, Entities: []MessageEntity{{Type: BotCommand, Offset: offset, Length: length}}}
+}
+
+func TestParseCommand(t *testing.T) {
+	caption := "/resize 50%"
+
+	tests := []struct {
+		name    string
+		message *Message
+		want    *Command
+	}{
+		{"command", commandMessage("/start", 0, 6), &Command{Name: "start"}},
+		{"arguments", commandMessage("/start  welcome back ", 0, 6), &Command{Name: "start", Args: "welcome back"}},
+		{"addressed", commandMessage("/start@Jobs_Bot x", 0, 15), &Command{Name: "start", Bot: "Jobs_Bot", Args: "x"}},
+		{"other bot", commandMessage("/start@other_bot", 0, 16), nil},
+		{"emoji arguments", commandMessage("/vote 👍🏽 yes", 0, 5), &Command{Name: "vote", Args: "👍🏽 yes"}},
+		{"accented arguments", commandMessage("/go héé", 0, 3), &Command{Name: "go", Args: "héé"}},
+		{"after emoji", commandMessage("😀 /go now", 3, 3), nil},
+		{"after accents", commandMessage("héé /go", 4, 3), nil},
+		{"mid-text command", commandMessage("please run /start now", 11, 6), nil},
+		{"first command", commandMessage("/a /b", 0, 2), &Command{Name: "a", Args: "/b"}},
+		{"second entity", &Message{Text: ptr("/a /b"), Entities: []MessageEntity{{Type: BotCommand, Offset: 3, Length: 2}}}, nil},
+		{"entity out of range", commandMessage("/go", 0, 5), nil},
+		{"entity inside a surrogate pair", commandMessage("/😀", 0, 2), nil},
+		{"no entity", &Message{Text: ptr("/start")}, nil},
+		{"other entity", &Message{Text: ptr("#start"), Entities: []MessageEntity{{Type: Hashtag, Length: 6}}}, nil},
+		{"caption", &Message{Caption: &caption, CaptionEntities: []MessageEntity{{Type: BotCommand, Length: 7}}}, &Command{Name: "resize", Args: "50%"}},
+		{"no text", &Message{}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ParseCommand(test.message, "@jobs_bot")
+			if ok != (test.want != nil) || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseCommand() = %+v, %v, want %+v", got, ok, test.want)
+			}
+		})
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		args string
+		want []string
+		err  error
+	}{
+		{"", []string{}, nil},
+		{"  ", []string{}, nil},
+		{"a b  c", []string{"a", "b", "c"}, nil},
+		{"\ta\nb ", []string{"a", "b"}, nil},
+		{`"a b" c`, []string{"a b", "c"}, nil},
+		{`'a b' c`, []string{"a b", "c"}, nil},
+		{`""`, []string{""}, nil},
+		{`a"b c"d`, []string{"ab cd"}, nil},
+		{`"it's" 'say "hi"'`, []string{"it's", `say "hi"`}, nil},
+		{`a\ b`, []string{"a b"}, nil},
+		{`"a \"b\""`, []string{`a "b"`}, nil},
+		{`'a\ b'`, []string{`a\ b`}, nil},
+		{`\\`, []string{`\`}, nil},
+		{`"😀 ü"`, []string{"😀 ü"}, nil},
+		{`"open`, nil, ErrUnterminatedQuote},
+		{`'open`, nil, ErrUnterminatedQuote},
+		{`a\`, nil, ErrUnterminatedQuote},
+	}
+
+	for _, test := range tests {
+		got, err := SplitArgs(test.args)
+		if !errors.Is(err, test.err) || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("SplitArgs(%q) = %q, %v, want %q, %v", test.args, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestCommandRegistry(t *testing.T) {
+	handled := []string{}
+
+	r := &CommandRegistry{Username: "jobs_bot"}
+	r.Register("start", "Starts the bot", func(ctx context.Context, message *Message, command *Command) error {
+		handled = append(handled, "start "+command.Args)
+		return nil
+	})
+	r.Register("/help", "", func(ctx context.Context, message *Message, command *Command) error {
+		handled = append(handled, "help")
+		return nil
+	})
+
+	tests := []struct {
+		message *Message
+		match   bool
+	}{
+		{commandMessage("/start x", 0, 6), true},
+		{commandMessage("/START@jobs_bot y", 0, 15), true},
+		{commandMessage("/help", 0, 5), true},
+		{commandMessage("/stop", 0, 5), false},
+		{commandMessage("/start@other_bot", 0, 16), false},
+		{&Message{Text: ptr("start")}, false},
+	}
+
+	for _, test := range tests {
+		if got := r.Filter().Match(test.message); got != test.match {
+			t.Errorf("Filter().Match(%q) = %v, want %v", *test.message.Text, got, test.match)
+		}
+
+		if err := r.HandleMessage(context.Background(), test.message); err != nil {
+			t.Errorf("HandleMessage(%q) = %v", *test.message.Text, err)
+		}
+	}
+
+	if want := []string{"start x", "start y", "help"}; !reflect.DeepEqual(handled, want) {
+		t.Errorf("handled %q, want %q", handled, want)
+	}
+
+	if want := "/start - Starts the bot\n/help"; r.Help() != want {
+		t.Errorf("Help() = %q, want %q", r.Help(), want)
+	}
+}
@@ -2,6 +2,38 @@ package telegram
 
 import "encoding/json"
 
+const (
+	TextType              string = "text"
+	AudioType             string = "audio"
+	VideoType             string = "video"
+	DocumentType          string = "document"
+	AnimationType         string = "animation"
+	GameType              string = "game"
+	PhotoType             string = "photo"
+	StickerType           string = "sticker"
+	VoiceType             string = "voice"
+	VideoNoteType         string = "video_note"
+	ContactType           string = "contact"
+	LocationType          string = "location"
+	VenueType             string = "venue"
+	InvoiceType           string = "invoice"
+	SuccessfulPaymentType string = "successful_payment"
+
+	NewChatMembersType        string = "new_chat_members"
+	LeftChatMemberType        string = "left_chat_member"
+	NewChatTitleType          string = "new_chat_title"
+	NewChatPhotoType          string = "new_chat_photo"
+	DeleteChatPhotoType       string = "delete_chat_photo"
+	GroupChatCreatedType      string = "group_chat_created"
+	SupergroupChatCreatedType string = "supergroup_chat_created"
+	ChannelChatCreatedType    string = "channel_chat_created"
+	MigrateToChatIDType       string = "migrate_to_chat_id"
+	MigrateFromChatIDType     string = "migrate_from_chat_id"
+	PinnedMessageType         string = "pinned_message"
+	ConnectedWebsiteType      string = "connected_website"
+	PassportDataType          string = "passport_data"
+)
+
 // Decode decodes the json data and set the type of message.
 func Decode(data json.RawMessage) (*Message, error) {
 	message := Message{}
@@ -11,35 +43,164 @@ func Decode(data json.RawMessage) (*Message, error) {
 		return nil, err
 	}
 
+	classifyMessage(&message)
+
+	return &message, nil
+}
+
+// DecodeUpdate decodes the json data of an update, sets the type of every message in it and returns the kind of the update.
+func DecodeUpdate(data json.RawMessage) (*Update, UpdateKind, error) {
+	update := Update{}
+
+	err := json.Unmarshal(data, &update)
+	if err != nil {
+		return nil, UnknownUpdate, err
+	}
+
+	classifyUpdate(&update)
+
+	return &update, update.Kind(), nil
+}
+
+// Kind returns the kind of the update, based on which of its optional fields is present.
+func (u *Update) Kind() UpdateKind {
+	switch {
+	case u.Message != nil:
+		return MessageUpdate
+	case u.EditedMessage != nil:
+		return EditedMessageUpdate
+	case u.ChannelPost != nil:
+		return ChannelPostUpdate
+	case u.EditedChannelPost != nil:
+		return EditedChannelPostUpdate
+	case u.InlineQuery != nil:
+		return InlineQueryUpdate
+	case u.ChosenInlineResult != nil:
+		return ChosenInlineResultUpdate
+	case u.CallbackQuery != nil:
+		return CallbackQueryUpdate
+	case u.ShippingQuery != nil:
+		return ShippingQueryUpdate
+	case u.PreCheckoutQuery != nil:
+		return PreCheckoutQueryUpdate
+	}
+
+	return UnknownUpdate
+}
+
+// classifyUpdate sets the type of every message in the update.
+func classifyUpdate(update *Update) {
+	classifyMessage(update.Message)
+	classifyMessage(update.EditedMessage)
+	classifyMessage(update.ChannelPost)
+	classifyMessage(update.EditedChannelPost)
+
+	if update.CallbackQuery != nil {
+		classifyMessage(update.CallbackQuery.Message)
+	}
+}
+
+// classifyResult sets the type of every message in the result of a request.
+func classifyResult(result interface{}) {
+	switch result := result.(type) {
+	case *Message:
+		classifyMessage(result)
+	case *[]Message:
+		for i := range *result {
+			classifyMessage(&(*result)[i])
+		}
+	case *[]Update:
+		for i := range *result {
+			classifyUpdate(&(*result)[i])
+		}
+	case *EditedMessage:
+		classifyMessage(result.Message)
+	case *Chat:
+		classifyMessage(result.PinnedMessage)
+	}
+}
+
+// classifyMessage sets the type of the message and of the messages nested in it.
+func classifyMessage(message *Message) {
+	if message == nil {
+		return
+	}
+
+	message.Type = messageType(message)
+
+	classifyMessage(message.ReplyToMessage)
+	classifyMessage(message.PinnedMessage)
+	classifyMessage(message.Chat.PinnedMessage)
+
+	if message.ForwardFromChat != nil {
+		classifyMessage(message.ForwardFromChat.PinnedMessage)
+	}
+}
+
+// messageType returns the type of the message. The first present field wins, in this order: text, the media and
+// content fields (audio, video, animation, document, game, photo, sticker, voice, video note, contact, venue, location,
+// invoice, successful payment) and then the service fields in the order of the Message struct. An animation comes before
+// a document and a venue before a location, since the Bot API sends the document of an animation and the location of a
+// venue too. Forwarded and replied messages are classified by their own
+// content, and captioned media by the media, since a caption is never stored in Text.
+func messageType(message *Message) string {
 	if message.Text != nil {
-		message.Type = TextType
+		return TextType
 	} else if message.Audio != nil {
-		message.Type = AudioType
+		return AudioType
 	} else if message.Video != nil {
-		message.Type = VideoType
+		return VideoType
+	} else if message.Animation != nil {
+		return AnimationType
 	} else if message.Document != nil {
-		message.Type = DocumentType
+		return DocumentType
 	} else if message.Game != nil {
-		message.Type = GameType
+		return GameType
 	} else if message.Photo != nil {
-		message.Type = PhotoType
+		return PhotoType
 	} else if message.Sticker != nil {
-		message.Type = StickerType
+		return StickerType
 	} else if message.Voice != nil {
-		message.Type = VoiceType
+		return VoiceType
 	} else if message.VideoNote != nil {
-		message.Type = VideoNoteType
+		return VideoNoteType
 	} else if message.Contact != nil {
-		message.Type = ContactType
-	} else if message.Location != nil {
-		message.Type = LocationType
+		return ContactType
 	} else if message.Venue != nil {
-		message.Type = VenueType
+		return VenueType
+	} else if message.Location != nil {
+		return LocationType
 	} else if message.Invoice != nil {
-		message.Type = InvoiceType
+		return InvoiceType
 	} else if message.SuccessfulPayment != nil {
-		message.Type = SuccessfulPaymentType
+		return SuccessfulPaymentType
+	} else if message.NewChatMembers != nil {
+		return NewChatMembersType
+	} else if message.LeftChatMember != nil {
+		return LeftChatMemberType
+	} else if message.NewChatTitle != nil {
+		return NewChatTitleType
+	} else if message.NewChatPhoto != nil {
+		return NewChatPhotoType
+	} else if message.DeleteChatPhoto != nil {
+		return DeleteChatPhotoType
+	} else if message.GroupChatCreated != nil {
+		return GroupChatCreatedType
+	} else if message.SupergroupChatCreated != nil {
+		return SupergroupChatCreatedType
+	} else if message.ChannelChatCreated != nil {
+		return ChannelChatCreatedType
+	} else if message.MigrateToChatID != nil {
+		return MigrateToChatIDType
+	} else if message.MigrateFromChatID != nil {
+		return MigrateFromChatIDType
+	} else if message.PinnedMessage != nil {
+		return PinnedMessageType
+	} else if message.ConnectedWebsite != nil {
+		return ConnectedWebsiteType
+	} else if message.PassportData != nil {
+		return PassportDataType
 	}
 
-	return &message, nil
+	return ""
 }
@@ -0,0 +1,144 @@
+package telegram
+
+import "testing"
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // Fields of the message besides message_id, date and chat
+		want    string
+	}{
+		{"text", `"text":"hi"`, TextType},
+		{"audio", `"audio":{"file_id":"a","duration":1}`, AudioType},
+		{"video", `"video":{"file_id":"v","width":1,"height":1,"duration":1}`, VideoType},
+		{"document", `"document":{"file_id":"d"}`, DocumentType},
+		{"animation", `"animation":{"file_id":"a","width":1,"height":1,"duration":1},"document":{"file_id":"a"}`, AnimationType},
+		{"game", `"game":{"title":"g","description":"d","photo":[]}`, GameType},
+		{"photo", `"photo":[{"file_id":"p","width":1,"height":1}]`, PhotoType},
+		{"sticker", `"sticker":{"file_id":"s","width":1,"height":1}`, StickerType},
+		{"voice", `"voice":{"file_id":"v","duration":1}`, VoiceType},
+		{"video note", `"video_note":{"file_id":"v","length":1,"duration":1}`, VideoNoteType},
+		{"contact", `"contact":{"phone_number":"1","first_name":"a"}`, ContactType},
+		{"location", `"location":{"longitude":1,"latitude":2}`, LocationType},
+		{"venue", `"location":{"longitude":1,"latitude":2},"venue":{"location":{"longitude":1,"latitude":2},"title":"t","address":"a"}`, VenueType},
+		{"invoice", `"invoice":{"title":"t","description":"d","start_parameter":"s","currency":"EUR","total_amount":1}`, InvoiceType},
+		{"successful payment", `"successful_payment":{"currency":"EUR","total_amount":1,"invoice_payload":"p","telegram_payment_charge_id":"t","provider_payment_charge_id":"p"}`, SuccessfulPaymentType},
+		{"new chat members", `"new_chat_members":[{"id":1,"is_bot":false,"first_name":"a"}]`, NewChatMembersType},
+		{"left chat member", `"left_chat_member":{"id":1,"is_bot":false,"first_name":"a"}`, LeftChatMemberType},
+		{"new chat title", `"new_chat_title":"t"`, NewChatTitleType},
+		{"new chat photo", `"new_chat_photo":[{"file_id":"p","width":1,"height":1}]`, NewChatPhotoType},
+		{"delete chat photo", `"delete_chat_photo":true`, DeleteChatPhotoType},
+		{"group chat created", `"group_chat_created":true`, GroupChatCreatedType},
+		{"supergroup chat created", `"supergroup_chat_created":true`, SupergroupChatCreatedType},
+		{"channel chat created", `"channel_chat_created":true`, ChannelChatCreatedType},
+		{"migrate to chat id", `"migrate_to_chat_id":-100`, MigrateToChatIDType},
+		{"migrate from chat id", `"migrate_from_chat_id":-1`, MigrateFromChatIDType},
+		{"pinned message", `"pinned_message":{"message_id":2,"date":1,"chat":{"id":1,"type":"group"},"text":"hi"}`, PinnedMessageType},
+		{"connected website", `"connected_website":"example.com"`, ConnectedWebsiteType},
+		{"passport data", `"passport_data":{"data":[{"type":"email","email":"a@example.com"}],"credentials":{"data":"d","hash":"h","secret":"s"}}`, PassportDataType},
+		{"empty", `"author_signature":"a"`, ""},
+
+		// Mixed messages
+		{"captioned photo", `"photo":[{"file_id":"p","width":1,"height":1}],"caption":"c","caption_entities":[]`, PhotoType},
+		{"captioned document", `"document":{"file_id":"d"},"caption":"c"`, DocumentType},
+		{"forwarded text", `"text":"hi","forward_from":{"id":2,"is_bot":false,"first_name":"b"},"forward_date":1`, TextType},
+		{"forwarded channel photo", `"photo":[{"file_id":"p","width":1,"height":1}],"forward_from_chat":{"id":-100,"type":"channel"},"forward_from_message_id":3,"forward_date":1`, PhotoType},
+		{"reply", `"sticker":{"file_id":"s","width":1,"height":1},"reply_to_message":{"message_id":2,"date":1,"chat":{"id":1,"type":"group"},"text":"hi"}`, StickerType},
+		{"text before media", `"text":"hi","photo":[{"file_id":"p","width":1,"height":1}]`, TextType},
+		{"media before service", `"photo":[{"file_id":"p","width":1,"height":1}],"new_chat_photo":[{"file_id":"p","width":1,"height":1}]`, PhotoType},
+		{"content before service", `"text":"hi","new_chat_members":[{"id":1,"is_bot":false,"first_name":"a"}]`, TextType},
+		{"service order", `"new_chat_members":[{"id":1,"is_bot":false,"first_name":"a"}],"left_chat_member":{"id":2,"is_bot":false,"first_name":"b"}`, NewChatMembersType},
+		{"migration", `"migrate_to_chat_id":-100,"migrate_from_chat_id":-1`, MigrateToChatIDType},
+		{"audio before document", `"audio":{"file_id":"a","duration":1},"document":{"file_id":"d"}`, AudioType},
+		{"game before photo", `"game":{"title":"g","description":"d","photo":[]},"photo":[{"file_id":"p","width":1,"height":1}]`, GameType},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			message, err := Decode([]byte(`{"message_id":1,"date":1,"chat":{"id":1,"type":"group"},` + test.content + `}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if message.Type != test.want {
+				t.Errorf("Type = %q, want %q", message.Type, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeNested(t *testing.T) {
+	message, err := Decode([]byte(`{"message_id":1,"date":1,"chat":{"id":1,"type":"group",` +
+		`"pinned_message":{"message_id":4,"date":1,"chat":{"id":1,"type":"group"},"sticker":{"file_id":"s","width":1,"height":1}}},` +
+		`"text":"hi","reply_to_message":{"message_id":2,"date":1,"chat":{"id":1,"type":"group"},"location":{"longitude":1,"latitude":2},` +
+		`"reply_to_message":{"message_id":3,"date":1,"chat":{"id":1,"type":"group"},"voice":{"file_id":"v","duration":1}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.Type != TextType || message.ReplyToMessage.Type != LocationType || message.ReplyToMessage.ReplyToMessage.Type != VoiceType ||
+		message.Chat.PinnedMessage.Type != StickerType {
+		t.Errorf("types = %q, %q, %q, %q", message.Type, message.ReplyToMessage.Type, message.ReplyToMessage.ReplyToMessage.Type,
+			message.Chat.PinnedMessage.Type)
+	}
+
+	message, err = Decode([]byte(`{"message_id":1,"date":1,"chat":{"id":1,"type":"group"},"pinned_message":` +
+		`{"message_id":2,"date":1,"chat":{"id":1,"type":"group"},"location":{"longitude":1,"latitude":2},` +
+		`"reply_to_message":{"message_id":3,"date":1,"chat":{"id":1,"type":"group"},"text":"hi"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.Type != PinnedMessageType || message.PinnedMessage.Type != LocationType || message.PinnedMessage.ReplyToMessage.Type != TextType {
+		t.Errorf("types = %q, %q, %q", message.Type, message.PinnedMessage.Type, message.PinnedMessage.ReplyToMessage.Type)
+	}
+
+	if _, err := Decode([]byte(`{"message_id":"1"}`)); err == nil {
+		t.Error("Decode() of an invalid message did not fail")
+	}
+}
+
+func TestDecodeUpdate(t *testing.T) {
+	message := `{"message_id":1,"date":1,"chat":{"id":1,"type":"private"},"text":"hi"}`
+
+	tests := []struct {
+		update string
+		kind   UpdateKind
+	}{
+		{`{"update_id":1,"message":` + message + `}`, MessageUpdate},
+		{`{"update_id":1,"edited_message":` + message + `}`, EditedMessageUpdate},
+		{`{"update_id":1,"channel_post":` + message + `}`, ChannelPostUpdate},
+		{`{"update_id":1,"edited_channel_post":` + message + `}`, EditedChannelPostUpdate},
+		{`{"update_id":1,"inline_query":{"id":"1","from":{"id":1,"is_bot":false,"first_name":"a"},"query":"q","offset":""}}`, InlineQueryUpdate},
+		{`{"update_id":1,"chosen_inline_result":{"result_id":"1","from":{"id":1,"is_bot":false,"first_name":"a"},"query":"q"}}`, ChosenInlineResultUpdate},
+		{`{"update_id":1,"callback_query":{"id":"1","from":{"id":1,"is_bot":false,"first_name":"a"},"message":` + message + `,"chat_instance":"c"}}`, CallbackQueryUpdate},
+		{`{"update_id":1,"shipping_query":{"id":"1","from":{"id":1,"is_bot":false,"first_name":"a"},"invoice_payload":"p","shipping_address":{"country_code":"HU","state":"","city":"B","street_line1":"s","street_line2":"","post_code":"1"}}}`, ShippingQueryUpdate},
+		{`{"update_id":1,"pre_checkout_query":{"id":"1","from":{"id":1,"is_bot":false,"first_name":"a"},"currency":"EUR","total_amount":1,"invoice_payload":"p"}}`, PreCheckoutQueryUpdate},
+		{`{"update_id":1}`, UnknownUpdate},
+	}
+
+	for _, test := range tests {
+		update, kind, err := DecodeUpdate([]byte(test.update))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if kind != test.kind {
+			t.Errorf("DecodeUpdate(%s) kind = %v, want %v", test.update, kind, test.kind)
+		}
+
+		for _, m := range []*Message{update.Message, update.EditedMessage, update.ChannelPost, update.EditedChannelPost} {
+			if m != nil && m.Type != TextType {
+				t.Errorf("DecodeUpdate(%s) message type = %q, want %q", test.update, m.Type, TextType)
+			}
+		}
+
+		if update.CallbackQuery != nil && update.CallbackQuery.Message.Type != TextType {
+			t.Errorf("callback query message type = %q, want %q", update.CallbackQuery.Message.Type, TextType)
+		}
+	}
+
+	if _, _, err := DecodeUpdate([]byte(`{"update_id":"1"}`)); err == nil {
+		t.Error("DecodeUpdate() of an invalid update did not fail")
+	}
+}
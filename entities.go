@@ -0,0 +1,189 @@
+package telegram
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// Markdown is a parse mode of the text of a message or a caption.
+	Markdown = "Markdown"
+	// HTML is a parse mode of the text of a message or a caption.
+	HTML = "HTML"
+)
+
+// EntityText returns the part of the text the entity refers to. It returns false if the entity is out of the text or
+// splits a surrogate pair.
+func EntityText(text string, entity MessageEntity) (string, bool) {
+	start, end, ok := utf16Range(text, entity.Offset, entity.Length)
+	if !ok {
+		return "", false
+	}
+
+	return text[start:end], true
+}
+
+// SliceText returns the part of the text at the offset and length given in UTF-16 code units, along with the entities
+// overlapping it, cut to the part and moved relative to its start. It returns false if the part is out of the text or
+// splits a surrogate pair.
+func SliceText(text string, entities []MessageEntity, offset, length int64) (string, []MessageEntity, bool) {
+	start, end, ok := utf16Range(text, offset, length)
+	if !ok {
+		return "", nil, false
+	}
+
+	return text[start:end], clipEntities(entities, offset, offset+length), true
+}
+
+// ShiftEntities returns a copy of the entities moved by delta UTF-16 code units, e.g. by UTF16Len(prefix) after prefixing the text.
+func ShiftEntities(entities []MessageEntity, delta int64) []MessageEntity {
+	if entities == nil {
+		return nil
+	}
+
+	shifted := make([]MessageEntity, len(entities))
+
+	for i, entity := range entities {
+		entity.Offset += delta
+		shifted[i] = entity
+	}
+
+	return shifted
+}
+
+// PrefixText returns the text with the prefix, along with the entities of the text moved after the prefix.
+func PrefixText(prefix, text string, entities []MessageEntity) (string, []MessageEntity) {
+	return prefix + text, ShiftEntities(entities, UTF16Len(prefix))
+}
+
+// TrimText returns the text without its leading and trailing white space, along with its entities cut and moved accordingly.
+func TrimText(text string, entities []MessageEntity) (string, []MessageEntity) {
+	left := strings.TrimLeftFunc(text, unicode.IsSpace)
+	trimmed := strings.TrimRightFunc(left, unicode.IsSpace)
+	offset := UTF16Len(text[:len(text)-len(left)])
+
+	return trimmed, clipEntities(entities, offset, offset+UTF16Len(trimmed))
+}
+
+// utf16Range returns the byte range of the text at the offset and length given in UTF-16 code units.
+func utf16Range(text string, offset, length int64) (int, int, bool) {
+	if length < 0 {
+		return 0, 0, false
+	}
+
+	start, ok := UTF16ToByteOffset(text, offset)
+	if !ok {
+		return 0, 0, false
+	}
+
+	end, ok := UTF16ToByteOffset(text[start:], length)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return start, start + end, true
+}
+
+// clipEntities returns the entities overlapping the range between start and end in UTF-16 code units, cut to the range and
+// moved relative to its start.
+func clipEntities(entities []MessageEntity, start, end int64) []MessageEntity {
+	var clipped []MessageEntity
+
+	for _, entity := range entities {
+		entityStart, entityEnd := entity.Offset, entity.Offset+entity.Length
+
+		if entityStart < start {
+			entityStart = start
+		}
+
+		if entityEnd > end {
+			entityEnd = end
+		}
+
+		if entityEnd <= entityStart {
+			continue
+		}
+
+		entity.Offset, entity.Length = entityStart-start, entityEnd-entityStart
+		clipped = append(clipped, entity)
+	}
+
+	return clipped
+}
+
+// entitySpan is a formatting entity with its byte range in the text.
+type entitySpan struct {
+	entity     MessageEntity
+	start, end int
+}
+
+// formattingSpans returns the non-empty formatting entities of the text, the ones which are written in HTML or Markdown:
+// bold, italic, code, pre, text_link and text_mention. The spans are sorted by their start, the outer one first.
+func formattingSpans(text string, entities []MessageEntity) []entitySpan {
+	spans := []entitySpan{}
+
+	for _, entity := range entities {
+		switch entity.Type {
+		case Bold, Italic, Code, Pre:
+		case TextLink:
+			if entity.URL == nil {
+				continue
+			}
+		case TextMention:
+			if entity.User == nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		start, end, ok := utf16Range(text, entity.Offset, entity.Length)
+		if !ok || start == end {
+			continue
+		}
+
+		spans = append(spans, entitySpan{entity: entity, start: start, end: end})
+	}
+
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].start != spans[j].start {
+			return spans[i].start < spans[j].start
+		}
+
+		return spans[i].end > spans[j].end
+	})
+
+	return spans
+}
+
+// sortEntities sorts the entities by their offset, the outer one first.
+func sortEntities(entities []MessageEntity) {
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+
+		return entities[i].Length > entities[j].Length
+	})
+}
+
+// mentionURL returns the url mentioning the user in HTML and Markdown.
+func mentionURL(user *User) string {
+	return "tg://user?id=" + strconv.FormatInt(user.ID, 10)
+}
+
+// mentionedUser returns the user mentioned by the url, or false if the url is not a mention.
+func mentionedUser(url string) (*User, bool) {
+	if !strings.HasPrefix(url, "tg://user?id=") {
+		return nil, false
+	}
+
+	id, err := strconv.ParseInt(strings.TrimPrefix(url, "tg://user?id="), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return &User{ID: id}, true
+}
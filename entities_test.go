@@ -0,0 +1,133 @@
+package telegram
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestSliceText(t *testing.T) {
+	entities := []MessageEntity{{Type: "bold", Offset: 0, Length: 4}, {Type: "italic", Offset: 5, Length: 3}}
+
+	tests := []struct {
+		offset, length int64
+		want           string
+		entities       []MessageEntity
+		ok             bool
+	}{
+		{0, 8, "😀ab éx", entities, true},
+		{2, 4, "ab e", []MessageEntity{{Type: "bold", Offset: 0, Length: 2}, {Type: "italic", Offset: 3, Length: 1}}, true},
+		{4, 1, " ", nil, true},
+		{1, 2, "", nil, false},
+		{0, 9, "", nil, false},
+		{0, -1, "", nil, false},
+	}
+
+	for _, test := range tests {
+		got, gotEntities, ok := SliceText("😀ab éx", entities, test.offset, test.length)
+		if got != test.want || ok != test.ok || !reflect.DeepEqual(gotEntities, test.entities) {
+			t.Errorf("SliceText(%d, %d) = %q, %v, %v, want %q, %v, %v", test.offset, test.length, got, gotEntities, ok, test.want, test.entities, test.ok)
+		}
+	}
+}
+
+func FuzzSliceText(f *testing.F) {
+	f.Add("a😀b𝄞c", int64(1), int64(2))
+	f.Add("a😀b𝄞c", int64(2), int64(2))
+	f.Add("é̂ text", int64(1), int64(3))
+	f.Add("👨‍👩‍👧", int64(2), int64(1))
+
+	f.Fuzz(func(t *testing.T, text string, offset, length int64) {
+		units := utf16.Encode([]rune(text))
+		whole := []MessageEntity{{Type: "bold", Offset: 0, Length: int64(len(units))}}
+
+		part, entities, ok := SliceText(text, whole, offset, length)
+		if !ok {
+			return
+		}
+
+		if offset < 0 || length < 0 || offset+length > int64(len(units)) {
+			t.Fatalf("SliceText(%q, %d, %d) is out of the text", text, offset, length)
+		}
+
+		if got := utf16.Encode([]rune(part)); !equalUnits(got, units[offset:offset+length]) {
+			t.Fatalf("SliceText(%q, %d, %d) = %q, encoding to %v, want %v", text, offset, length, part, got, units[offset:offset+length])
+		}
+
+		if length == 0 {
+			if len(entities) != 0 {
+				t.Fatalf("SliceText(%q, %d, 0) has entities %v", text, offset, entities)
+			}
+
+			return
+		}
+
+		if len(entities) != 1 || entities[0].Offset != 0 || entities[0].Length != length {
+			t.Fatalf("SliceText(%q, %d, %d) has entities %v, want the whole part", text, offset, length, entities)
+		}
+
+		if got, ok := EntityText(part, entities[0]); !ok || got != part {
+			t.Fatalf("EntityText(%q, %v) = %q, %v", part, entities[0], got, ok)
+		}
+	})
+}
+
+func TestPrefixText(t *testing.T) {
+	text := "é 😀 bold"
+	entities := []MessageEntity{{Type: "bold", Offset: 5, Length: 4}, {Type: "italic", Offset: 3, Length: 2}}
+
+	for _, prefix := range []string{"", "> ", "😀 ", "é̂"} {
+		got, shifted := PrefixText(prefix, text, entities)
+		if got != prefix+text {
+			t.Errorf("PrefixText(%q) = %q", prefix, got)
+		}
+
+		for i, entity := range entities {
+			want, _ := EntityText(text, entity)
+			if part, ok := EntityText(got, shifted[i]); !ok || part != want {
+				t.Errorf("PrefixText(%q) entity %d is %q, %v, want %q", prefix, i, part, ok, want)
+			}
+		}
+	}
+
+	if entities[0].Offset != 5 {
+		t.Errorf("PrefixText modified the entities: %v", entities)
+	}
+}
+
+func TestTrimText(t *testing.T) {
+	tests := []struct {
+		text     string
+		entities []MessageEntity
+		want     string
+		parts    []string
+	}{
+		{"  😀 bold  ", []MessageEntity{{Type: "bold", Offset: 5, Length: 4}}, "😀 bold", []string{"bold"}},
+		{"\n text \n", []MessageEntity{{Type: "bold", Offset: 0, Length: 8}}, "text", []string{"text"}},
+		{"  text", []MessageEntity{{Type: "code", Offset: 0, Length: 2}}, "text", nil},
+		{"é \t", []MessageEntity{{Type: "italic", Offset: 0, Length: 2}}, "é", []string{"é"}},
+		{"   ", []MessageEntity{{Type: "bold", Offset: 0, Length: 3}}, "", nil},
+	}
+
+	for _, test := range tests {
+		got, entities := TrimText(test.text, test.entities)
+		if got != test.want || got != strings.TrimSpace(test.text) {
+			t.Errorf("TrimText(%q) = %q, want %q", test.text, got, test.want)
+		}
+
+		parts := []string(nil)
+		for _, entity := range entities {
+			part, ok := EntityText(got, entity)
+			if !ok {
+				t.Errorf("TrimText(%q) entity %v is out of %q", test.text, entity, got)
+			}
+
+			parts = append(parts, part)
+		}
+
+		if !reflect.DeepEqual(parts, test.parts) {
+			t.Errorf("TrimText(%q) entities are %q, want %q", test.text, parts, test.parts)
+		}
+	}
+}
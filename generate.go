@@ -0,0 +1,6 @@
+package telegram
+
+// The structs of the Bot API, their constants and metadata are generated from schema/botapi.json and
+// schema/gohints.json. Run go run ./cmd/botapigen -snapshot with a snapshot of the Bot API reference to update the
+// schema to a new version of the Bot API.
+//go:generate go run ./cmd/botapigen
@@ -0,0 +1,3 @@
+module github.com/hellowearemito/go-telegram-structs
+
+go 1.24
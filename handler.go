@@ -0,0 +1,16 @@
+package telegram
+
+import "context"
+
+// Handler responds to an update.
+type Handler interface {
+	HandleUpdate(ctx context.Context, update *Update) error
+}
+
+// HandlerFunc is an adapter to use an ordinary function as a Handler.
+type HandlerFunc func(ctx context.Context, update *Update) error
+
+// HandleUpdate calls f(ctx, update).
+func (f HandlerFunc) HandleUpdate(ctx context.Context, update *Update) error {
+	return f(ctx, update)
+}
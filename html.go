@@ -0,0 +1,257 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+var (
+	htmlTextEscaper      = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	htmlAttributeEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// EscapeHTML escapes the text to be sent with the HTML parse mode.
+func EscapeHTML(text string) string {
+	return htmlTextEscaper.Replace(text)
+}
+
+// RenderHTML returns the text with its entities written as Telegram HTML, e.g. to send a received message again with the HTML
+// parse mode. Nested entities are nested tags, and an entity overlapping the end of another one is closed and opened again.
+func RenderHTML(text string, entities []MessageEntity) string {
+	spans := formattingSpans(text, entities)
+
+	boundaries := []int{0, len(text)}
+	for _, span := range spans {
+		boundaries = append(boundaries, span.start, span.end)
+	}
+
+	sort.Ints(boundaries)
+
+	result := strings.Builder{}
+	open := []entitySpan{}
+
+	for i := 0; i+1 < len(boundaries); i++ {
+		from, to := boundaries[i], boundaries[i+1]
+		if from == to {
+			continue
+		}
+
+		active := []entitySpan{}
+		for _, span := range spans {
+			if span.start <= from && span.end >= to {
+				active = append(active, span)
+			}
+		}
+
+		common := 0
+		for common < len(open) && common < len(active) && open[common] == active[common] {
+			common++
+		}
+
+		for j := len(open) - 1; j >= common; j-- {
+			result.WriteString(htmlEndTag(open[j].entity))
+		}
+
+		for _, span := range active[common:] {
+			result.WriteString(htmlStartTag(span.entity))
+		}
+
+		open = active
+		result.WriteString(EscapeHTML(text[from:to]))
+	}
+
+	for j := len(open) - 1; j >= 0; j-- {
+		result.WriteString(htmlEndTag(open[j].entity))
+	}
+
+	return result.String()
+}
+
+// MessageHTML returns the text or the caption of the message with its entities written as Telegram HTML.
+func MessageHTML(message *Message) string {
+	if message.Text != nil {
+		return RenderHTML(*message.Text, message.Entities)
+	}
+
+	if message.Caption != nil {
+		return RenderHTML(*message.Caption, message.CaptionEntities)
+	}
+
+	return ""
+}
+
+// ParseHTML returns the text and the entities of Telegram HTML, the opposite of RenderHTML. The supported tags are b, strong,
+// i, em, code, pre and a. A link to tg://user?id=<user_id> is a text_mention.
+func ParseHTML(s string) (string, []MessageEntity, error) {
+	type openTag struct {
+		name   string
+		entity MessageEntity
+	}
+
+	text := strings.Builder{}
+	units := int64(0)
+	stack := []openTag{}
+	entities := []MessageEntity{}
+
+	for s != "" {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			i = len(s)
+		}
+
+		chunk := html.UnescapeString(s[:i])
+		text.WriteString(chunk)
+		units += UTF16Len(chunk)
+
+		s = s[i:]
+		if s == "" {
+			break
+		}
+
+		j := strings.IndexByte(s, '>')
+		if j < 0 {
+			return "", nil, fmt.Errorf("telegram: can't parse HTML: unclosed tag %q", s)
+		}
+
+		tag := strings.TrimSpace(s[1:j])
+		s = s[j+1:]
+
+		if strings.HasPrefix(tag, "/") {
+			name := strings.ToLower(strings.TrimSpace(tag[1:]))
+			if len(stack) == 0 || stack[len(stack)-1].name != name {
+				return "", nil, fmt.Errorf("telegram: can't parse HTML: unexpected end tag </%s>", name)
+			}
+
+			entity := stack[len(stack)-1].entity
+			stack = stack[:len(stack)-1]
+
+			entity.Length = units - entity.Offset
+			if entity.Length > 0 {
+				entities = append(entities, entity)
+			}
+
+			continue
+		}
+
+		name, attributes := tag, ""
+		if k := strings.IndexAny(tag, " \t\n"); k >= 0 {
+			name, attributes = tag[:k], tag[k+1:]
+		}
+
+		name = strings.ToLower(name)
+
+		entity, err := htmlTagEntity(name, attributes)
+		if err != nil {
+			return "", nil, err
+		}
+
+		entity.Offset = units
+		stack = append(stack, openTag{name: name, entity: entity})
+	}
+
+	if len(stack) > 0 {
+		return "", nil, fmt.Errorf("telegram: can't parse HTML: unclosed tag <%s>", stack[len(stack)-1].name)
+	}
+
+	sortEntities(entities)
+
+	return text.String(), entities, nil
+}
+
+// htmlStartTag returns the start tag of the entity.
+func htmlStartTag(entity MessageEntity) string {
+	switch entity.Type {
+	case Bold:
+		return "<b>"
+	case Italic:
+		return "<i>"
+	case Code:
+		return "<code>"
+	case Pre:
+		return "<pre>"
+	case TextLink:
+		return `<a href="` + htmlAttributeEscaper.Replace(*entity.URL) + `">`
+	case TextMention:
+		return `<a href="` + mentionURL(entity.User) + `">`
+	}
+
+	return ""
+}
+
+// htmlEndTag returns the end tag of the entity.
+func htmlEndTag(entity MessageEntity) string {
+	switch entity.Type {
+	case Bold:
+		return "</b>"
+	case Italic:
+		return "</i>"
+	case Code:
+		return "</code>"
+	case Pre:
+		return "</pre>"
+	case TextLink, TextMention:
+		return "</a>"
+	}
+
+	return ""
+}
+
+// htmlTagEntity returns the entity of the start tag with the given name and attributes.
+func htmlTagEntity(name, attributes string) (MessageEntity, error) {
+	switch name {
+	case "b", "strong":
+		return MessageEntity{Type: Bold}, nil
+	case "i", "em":
+		return MessageEntity{Type: Italic}, nil
+	case "code":
+		return MessageEntity{Type: Code}, nil
+	case "pre":
+		return MessageEntity{Type: Pre}, nil
+	case "a":
+		url, ok := htmlHref(attributes)
+		if !ok {
+			return MessageEntity{}, fmt.Errorf("telegram: can't parse HTML: no href in <a %s>", attributes)
+		}
+
+		if user, ok := mentionedUser(url); ok {
+			return MessageEntity{Type: TextMention, User: user}, nil
+		}
+
+		return MessageEntity{Type: TextLink, URL: &url}, nil
+	}
+
+	return MessageEntity{}, fmt.Errorf("telegram: can't parse HTML: unsupported tag <%s>", name)
+}
+
+// htmlHref returns the unescaped value of the href attribute.
+func htmlHref(attributes string) (string, bool) {
+	attributes = strings.TrimSpace(attributes)
+	if !strings.HasPrefix(strings.ToLower(attributes), "href") {
+		return "", false
+	}
+
+	attributes = strings.TrimSpace(attributes[len("href"):])
+	if !strings.HasPrefix(attributes, "=") {
+		return "", false
+	}
+
+	value := strings.TrimSpace(attributes[1:])
+	if value == "" {
+		return "", false
+	}
+
+	if quote := value[0]; quote == '"' || quote == '\'' {
+		end := strings.IndexByte(value[1:], quote)
+		if end < 0 {
+			return "", false
+		}
+
+		value = value[1 : end+1]
+	} else if k := strings.IndexAny(value, " \t\n"); k >= 0 {
+		value = value[:k]
+	}
+
+	return html.UnescapeString(value), true
+}
@@ -0,0 +1,127 @@
+package telegram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderHTML(t *testing.T) {
+	link := `http://example.com/?a=1&b="2"`
+
+	tests := []struct {
+		name     string
+		text     string
+		entities []MessageEntity
+		html     string
+		parsed   []MessageEntity // Entities parsed from the HTML, if they differ from the rendered ones
+	}{
+		{
+			name: "plain",
+			text: "a < b & c > d",
+			html: "a &lt; b &amp; c &gt; d",
+		},
+		{
+			name:     "bold",
+			text:     "a bold b",
+			entities: []MessageEntity{{Type: Bold, Offset: 2, Length: 4}},
+			html:     "a <b>bold</b> b",
+		},
+		{
+			name:     "nested",
+			text:     "bold italic",
+			entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 11}, {Type: Italic, Offset: 5, Length: 6}},
+			html:     "<b>bold <i>italic</i></b>",
+		},
+		{
+			name:     "overlapping",
+			text:     "abcdef",
+			entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 4}, {Type: Italic, Offset: 2, Length: 4}},
+			html:     "<b>ab<i>cd</i></b><i>ef</i>",
+			parsed:   []MessageEntity{{Type: Bold, Offset: 0, Length: 4}, {Type: Italic, Offset: 2, Length: 2}, {Type: Italic, Offset: 4, Length: 2}},
+		},
+		{
+			name:     "escaped entity",
+			text:     "<b> & </b>",
+			entities: []MessageEntity{{Type: Code, Offset: 0, Length: 10}},
+			html:     "<code>&lt;b&gt; &amp; &lt;/b&gt;</code>",
+		},
+		{
+			name:     "link",
+			text:     "see link",
+			entities: []MessageEntity{{Type: TextLink, Offset: 4, Length: 4, URL: &link}},
+			html:     `see <a href="http://example.com/?a=1&amp;b=&quot;2&quot;">link</a>`,
+		},
+		{
+			name:     "mention",
+			text:     "hi user",
+			entities: []MessageEntity{{Type: TextMention, Offset: 3, Length: 4, User: &User{ID: 42}}},
+			html:     `hi <a href="tg://user?id=42">user</a>`,
+		},
+		{
+			name:     "surrogate pairs",
+			text:     "😀 bold 𝄞 pre",
+			entities: []MessageEntity{{Type: Bold, Offset: 3, Length: 4}, {Type: Pre, Offset: 8, Length: 6}},
+			html:     "😀 <b>bold</b> <pre>𝄞 pre</pre>",
+		},
+		{
+			name:     "not formatting",
+			text:     "#tag @user",
+			entities: []MessageEntity{{Type: "hashtag", Offset: 0, Length: 4}, {Type: "mention", Offset: 5, Length: 5}},
+			html:     "#tag @user",
+			parsed:   []MessageEntity{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RenderHTML(test.text, test.entities); got != test.html {
+				t.Fatalf("RenderHTML() = %q, want %q", got, test.html)
+			}
+
+			text, entities, err := ParseHTML(test.html)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := test.parsed
+			if want == nil {
+				want = append([]MessageEntity{}, test.entities...)
+			}
+
+			if text != test.text || !reflect.DeepEqual(entities, want) {
+				t.Errorf("ParseHTML() = %q, %+v, want %q, %+v", text, entities, test.text, want)
+			}
+		})
+	}
+}
+
+func TestParseHTML(t *testing.T) {
+	tests := []struct {
+		html     string
+		text     string
+		entities []MessageEntity
+		err      bool
+	}{
+		{html: "<strong>b</strong><em>i</em>", text: "bi", entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 1}, {Type: Italic, Offset: 1, Length: 1}}},
+		{html: "<B>b</B>", text: "b", entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 1}}},
+		{html: "<b></b>x", text: "x", entities: []MessageEntity{}},
+		{html: "&quot;&#39;&lt;", text: `"'<`, entities: []MessageEntity{}},
+		{html: "<b>x", err: true},
+		{html: "<b>x</i>", err: true},
+		{html: "<u>x</u>", err: true},
+		{html: "<a>x</a>", err: true},
+		{html: "<b", err: true},
+	}
+
+	for _, test := range tests {
+		text, entities, err := ParseHTML(test.html)
+		if (err != nil) != test.err {
+			t.Errorf("ParseHTML(%q) error = %v, want error %v", test.html, err, test.err)
+			continue
+		}
+
+		if !test.err && (text != test.text || !reflect.DeepEqual(entities, test.entities)) {
+			t.Errorf("ParseHTML(%q) = %q, %+v, want %q, %+v", test.html, text, entities, test.text, test.entities)
+		}
+	}
+}
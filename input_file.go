@@ -0,0 +1,37 @@
+package telegram
+
+import (
+	"errors"
+	"io"
+)
+
+// InputFile represents the contents of a file to be uploaded. Must be posted using multipart/form-data in the usual way that files are uploaded via the browser.
+// It should be one of FileID, FileURL, FileReader or FilePath. A plain string is sent as it is, as a file_id or an url.
+type InputFile interface{}
+
+// FileID is an InputFile of a file that exists on the Telegram servers.
+type FileID string
+
+// FileURL is an InputFile of an HTTP URL for Telegram to get a file from the Internet.
+type FileURL string
+
+// FileReader is an InputFile uploaded from a reader using multipart/form-data.
+type FileReader struct {
+	Name   string    // Name of the uploaded file
+	Reader io.Reader // Contents of the uploaded file
+}
+
+// MarshalJSON returns an error, since a FileReader can only be uploaded using multipart/form-data.
+func (f FileReader) MarshalJSON() ([]byte, error) {
+	return nil, errMultipartOnly
+}
+
+// FilePath is an InputFile uploaded from the local file system using multipart/form-data.
+type FilePath string
+
+// MarshalJSON returns an error, since a FilePath can only be uploaded using multipart/form-data.
+func (f FilePath) MarshalJSON() ([]byte, error) {
+	return nil, errMultipartOnly
+}
+
+var errMultipartOnly = errors.New("telegram: the file must be uploaded using multipart/form-data")
@@ -0,0 +1,310 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// MaxCallbackDataLength is the maximum length of the callback data of a button, in bytes.
+	MaxCallbackDataLength = 64
+	// MaxInlineKeyboardRowButtons is the maximum number of buttons in a row of an inline keyboard.
+	MaxInlineKeyboardRowButtons = 8
+	// MaxInlineKeyboardButtons is the maximum number of buttons of an inline keyboard.
+	MaxInlineKeyboardButtons = 100
+	// MaxReplyKeyboardRowButtons is the maximum number of buttons in a row of a reply keyboard.
+	MaxReplyKeyboardRowButtons = 12
+	// MaxReplyKeyboardButtons is the maximum number of buttons of a reply keyboard.
+	MaxReplyKeyboardButtons = 300
+)
+
+// ErrInvalidKeyboard is wrapped by the errors of the keyboard validation.
+var ErrInvalidKeyboard = errors.New("telegram: invalid keyboard")
+
+// URLButton returns an inline keyboard button opening the url.
+func URLButton(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, URL: &url}
+}
+
+// CallbackButton returns an inline keyboard button sending a callback query with the data, of 1-64 bytes.
+func CallbackButton(text, data string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackData: &data}
+}
+
+// SwitchInlineButton returns an inline keyboard button prompting the user to select a chat and inserting the bot's username
+// and the query in its input field.
+func SwitchInlineButton(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchInlineQuery: &query}
+}
+
+// SwitchInlineCurrentChatButton returns an inline keyboard button inserting the bot's username and the query in the input
+// field of the current chat.
+func SwitchInlineCurrentChatButton(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchInlineQueryCurrentChat: &query}
+}
+
+// GameButton returns an inline keyboard button launching the game of the message. It must be the first button of the keyboard.
+func GameButton(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// PayButton returns an inline keyboard button paying the invoice of the message. It must be the first button of the keyboard.
+func PayButton(text string) InlineKeyboardButton {
+	pay := true
+	return InlineKeyboardButton{Text: text, Pay: &pay}
+}
+
+// TextButton returns a reply keyboard button sending its text.
+func TextButton(text string) KeyboardButton {
+	return KeyboardButton{Text: text}
+}
+
+// ContactButton returns a reply keyboard button sending the user's phone number. Available in private chats only.
+func ContactButton(text string) KeyboardButton {
+	requestContact := true
+	return KeyboardButton{Text: text, RequestContact: &requestContact}
+}
+
+// LocationButton returns a reply keyboard button sending the user's current location. Available in private chats only.
+func LocationButton(text string) KeyboardButton {
+	requestLocation := true
+	return KeyboardButton{Text: text, RequestLocation: &requestLocation}
+}
+
+// InlineKeyboardBuilder builds an inline keyboard row by row, e.g.
+// new(InlineKeyboardBuilder).Row(URLButton("Site", url)).Grid(3, buttons...).Build().
+// The zero value is an empty keyboard.
+type InlineKeyboardBuilder struct {
+	rows [][]InlineKeyboardButton
+}
+
+// Row appends a row of a copy of the buttons.
+func (b *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	b.rows = append(b.rows, append([]InlineKeyboardButton(nil), buttons...))
+	return b
+}
+
+// Column appends a row for each of the buttons.
+func (b *InlineKeyboardBuilder) Column(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	return b.Grid(1, buttons...)
+}
+
+// Grid appends the buttons in rows of the given number of columns, the last row holding the remaining buttons.
+func (b *InlineKeyboardBuilder) Grid(columns int, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	for _, row := range gridRows(len(buttons), columns) {
+		b.rows = append(b.rows, append([]InlineKeyboardButton(nil), buttons[row[0]:row[1]]...))
+	}
+
+	return b
+}
+
+// Build returns the keyboard, or an error if it is invalid.
+func (b *InlineKeyboardBuilder) Build() (*InlineKeyboardMarkup, error) {
+	markup := &InlineKeyboardMarkup{InlineKeyboard: b.rows}
+	if markup.InlineKeyboard == nil {
+		markup.InlineKeyboard = [][]InlineKeyboardButton{}
+	}
+
+	if err := markup.Validate(); err != nil {
+		return nil, err
+	}
+
+	return markup, nil
+}
+
+// Validate checks the limits of the keyboard and its buttons, and that a game or pay button is the first button.
+func (m InlineKeyboardMarkup) Validate() error {
+	count := 0
+
+	for i, row := range m.InlineKeyboard {
+		if err := validateRow(i, len(row), MaxInlineKeyboardRowButtons); err != nil {
+			return err
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("%w: button %d of row %d: %v", ErrInvalidKeyboard, j+1, i+1, err)
+			}
+
+			if (button.CallbackGame != nil || button.Pay != nil) && (i > 0 || j > 0) {
+				return fmt.Errorf("%w: button %d of row %d: a game or pay button must be the first button", ErrInvalidKeyboard, j+1, i+1)
+			}
+		}
+
+		count += len(row)
+	}
+
+	if count > MaxInlineKeyboardButtons {
+		return fmt.Errorf("%w: %d buttons, more than %d", ErrInvalidKeyboard, count, MaxInlineKeyboardButtons)
+	}
+
+	return nil
+}
+
+// Validate checks that the button has a text and exactly one action, and the length of its callback data.
+func (b InlineKeyboardButton) Validate() error {
+	if b.Text == "" {
+		return errors.New("no text")
+	}
+
+	actions := 0
+
+	for _, set := range []bool{
+		b.URL != nil,
+		b.CallbackData != nil,
+		b.SwitchInlineQuery != nil,
+		b.SwitchInlineQueryCurrentChat != nil,
+		b.CallbackGame != nil,
+		b.Pay != nil,
+	} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions != 1 {
+		return fmt.Errorf("%d actions instead of exactly one", actions)
+	}
+
+	if b.CallbackData != nil && (len(*b.CallbackData) == 0 || len(*b.CallbackData) > MaxCallbackDataLength) {
+		return fmt.Errorf("callback data of %d bytes, not 1-%d", len(*b.CallbackData), MaxCallbackDataLength)
+	}
+
+	return nil
+}
+
+// ReplyKeyboardBuilder builds a reply keyboard row by row, e.g.
+// new(ReplyKeyboardBuilder).Grid(2, TextButton("Yes"), TextButton("No")).OneTime().Build().
+// The zero value is an empty keyboard.
+type ReplyKeyboardBuilder struct {
+	markup ReplyKeyboardMarkup
+}
+
+// Row appends a row of a copy of the buttons.
+func (b *ReplyKeyboardBuilder) Row(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	b.markup.Keyboard = append(b.markup.Keyboard, append([]KeyboardButton(nil), buttons...))
+	return b
+}
+
+// Column appends a row for each of the buttons.
+func (b *ReplyKeyboardBuilder) Column(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	return b.Grid(1, buttons...)
+}
+
+// Grid appends the buttons in rows of the given number of columns, the last row holding the remaining buttons.
+func (b *ReplyKeyboardBuilder) Grid(columns int, buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	for _, row := range gridRows(len(buttons), columns) {
+		b.markup.Keyboard = append(b.markup.Keyboard, append([]KeyboardButton(nil), buttons[row[0]:row[1]]...))
+	}
+
+	return b
+}
+
+// Resize requests clients to resize the keyboard vertically for optimal fit.
+func (b *ReplyKeyboardBuilder) Resize() *ReplyKeyboardBuilder {
+	resize := true
+	b.markup.ResizeKeyboard = &resize
+
+	return b
+}
+
+// OneTime requests clients to hide the keyboard as soon as it's been used.
+func (b *ReplyKeyboardBuilder) OneTime() *ReplyKeyboardBuilder {
+	oneTime := true
+	b.markup.OneTimeKeyboard = &oneTime
+
+	return b
+}
+
+// Selective shows the keyboard only to the users mentioned in the text of the message and the sender of the replied message.
+func (b *ReplyKeyboardBuilder) Selective() *ReplyKeyboardBuilder {
+	selective := true
+	b.markup.Selective = &selective
+
+	return b
+}
+
+// Build returns the keyboard, or an error if it is invalid.
+func (b *ReplyKeyboardBuilder) Build() (*ReplyKeyboardMarkup, error) {
+	markup := b.markup
+	if markup.Keyboard == nil {
+		markup.Keyboard = [][]KeyboardButton{}
+	}
+
+	if err := markup.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &markup, nil
+}
+
+// Validate checks the limits of the keyboard and its buttons.
+func (m ReplyKeyboardMarkup) Validate() error {
+	count := 0
+
+	for i, row := range m.Keyboard {
+		if err := validateRow(i, len(row), MaxReplyKeyboardRowButtons); err != nil {
+			return err
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("%w: button %d of row %d: %v", ErrInvalidKeyboard, j+1, i+1, err)
+			}
+		}
+
+		count += len(row)
+	}
+
+	if count > MaxReplyKeyboardButtons {
+		return fmt.Errorf("%w: %d buttons, more than %d", ErrInvalidKeyboard, count, MaxReplyKeyboardButtons)
+	}
+
+	return nil
+}
+
+// Validate checks that the button has a text and at most one request.
+func (b KeyboardButton) Validate() error {
+	if b.Text == "" {
+		return errors.New("no text")
+	}
+
+	if b.RequestContact != nil && *b.RequestContact && b.RequestLocation != nil && *b.RequestLocation {
+		return errors.New("both a contact and a location request")
+	}
+
+	return nil
+}
+
+// validateRow checks the number of buttons of the row with the given index.
+func validateRow(index, buttons, max int) error {
+	if buttons == 0 {
+		return fmt.Errorf("%w: row %d is empty", ErrInvalidKeyboard, index+1)
+	}
+
+	if buttons > max {
+		return fmt.Errorf("%w: row %d has %d buttons, more than %d", ErrInvalidKeyboard, index+1, buttons, max)
+	}
+
+	return nil
+}
+
+// gridRows returns the start and end indexes of the rows laying out the given number of buttons in columns.
+func gridRows(buttons, columns int) [][2]int {
+	if columns < 1 {
+		columns = 1
+	}
+
+	rows := [][2]int{}
+
+	for start := 0; start < buttons; start += columns {
+		end := start + columns
+		if end > buttons {
+			end = buttons
+		}
+
+		rows = append(rows, [2]int{start, end})
+	}
+
+	return rows
+}
@@ -0,0 +1,178 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// callbackButtons returns n callback buttons.
+func callbackButtons(n int) []InlineKeyboardButton {
+	buttons := make([]InlineKeyboardButton, n)
+	for i := range buttons {
+		buttons[i] = CallbackButton(fmt.Sprint(i), fmt.Sprint(i))
+	}
+
+	return buttons
+}
+
+// textButtons returns n text buttons.
+func textButtons(n int) []KeyboardButton {
+	buttons := make([]KeyboardButton, n)
+	for i := range buttons {
+		buttons[i] = TextButton(fmt.Sprint(i))
+	}
+
+	return buttons
+}
+
+func TestInlineKeyboardValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *InlineKeyboardBuilder
+		invalid string // Part of the error, empty if the keyboard is valid
+	}{
+		{"empty", new(InlineKeyboardBuilder), ""},
+		{"full row", new(InlineKeyboardBuilder).Row(callbackButtons(MaxInlineKeyboardRowButtons)...), ""},
+		{"long row", new(InlineKeyboardBuilder).Row(callbackButtons(MaxInlineKeyboardRowButtons + 1)...), "row 1 has 9 buttons"},
+		{"empty row", new(InlineKeyboardBuilder).Row(CallbackButton("a", "a")).Row(), "row 2 is empty"},
+		{"full keyboard", new(InlineKeyboardBuilder).Grid(5, callbackButtons(MaxInlineKeyboardButtons)...), ""},
+		{"large keyboard", new(InlineKeyboardBuilder).Grid(5, callbackButtons(MaxInlineKeyboardButtons+1)...), "101 buttons"},
+		{"longest callback data", new(InlineKeyboardBuilder).Row(CallbackButton("a", strings.Repeat("é", MaxCallbackDataLength/2))), ""},
+		{"long callback data", new(InlineKeyboardBuilder).Row(CallbackButton("a", strings.Repeat("x", MaxCallbackDataLength+1))), "callback data of 65 bytes"},
+		{"empty callback data", new(InlineKeyboardBuilder).Row(CallbackButton("a", "")), "callback data of 0 bytes"},
+		{"no text", new(InlineKeyboardBuilder).Row(URLButton("", "https://example.com")), "no text"},
+		{"no action", new(InlineKeyboardBuilder).Row(InlineKeyboardButton{Text: "a"}), "0 actions"},
+		{"two actions", new(InlineKeyboardBuilder).Row(InlineKeyboardButton{Text: "a", URL: ptr("u"), CallbackData: ptr("d")}), "2 actions"},
+		{"actions", new(InlineKeyboardBuilder).Row(SwitchInlineButton("a", ""), SwitchInlineCurrentChatButton("b", "q")), ""},
+		{"game first", new(InlineKeyboardBuilder).Row(GameButton("Play"), URLButton("Site", "https://example.com")), ""},
+		{"pay first", new(InlineKeyboardBuilder).Row(PayButton("Pay")).Row(URLButton("Site", "https://example.com")), ""},
+		{"game second", new(InlineKeyboardBuilder).Row(URLButton("Site", "https://example.com"), GameButton("Play")), "button 2 of row 1: a game or pay button"},
+		{"pay in second row", new(InlineKeyboardBuilder).Row(CallbackButton("a", "a")).Row(PayButton("Pay")), "button 1 of row 2: a game or pay button"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			markup, err := test.builder.Build()
+			if test.invalid == "" {
+				if err != nil || markup == nil || markup.InlineKeyboard == nil {
+					t.Errorf("Build() = %v, %v, want a keyboard", markup, err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrInvalidKeyboard) || !strings.Contains(err.Error(), test.invalid) || markup != nil {
+				t.Errorf("Build() = %v, %v, want an ErrInvalidKeyboard about %q", markup, err, test.invalid)
+			}
+		})
+	}
+}
+
+func TestReplyKeyboardValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *ReplyKeyboardBuilder
+		invalid string // Part of the error, empty if the keyboard is valid
+	}{
+		{"empty", new(ReplyKeyboardBuilder), ""},
+		{"full row", new(ReplyKeyboardBuilder).Row(textButtons(MaxReplyKeyboardRowButtons)...), ""},
+		{"long row", new(ReplyKeyboardBuilder).Row(textButtons(MaxReplyKeyboardRowButtons + 1)...), "row 1 has 13 buttons"},
+		{"empty row", new(ReplyKeyboardBuilder).Row(), "row 1 is empty"},
+		{"full keyboard", new(ReplyKeyboardBuilder).Grid(10, textButtons(MaxReplyKeyboardButtons)...), ""},
+		{"large keyboard", new(ReplyKeyboardBuilder).Grid(10, textButtons(MaxReplyKeyboardButtons+1)...), "301 buttons"},
+		{"no text", new(ReplyKeyboardBuilder).Row(TextButton("")), "no text"},
+		{"requests", new(ReplyKeyboardBuilder).Row(ContactButton("Phone"), LocationButton("Location")), ""},
+		{"two requests", new(ReplyKeyboardBuilder).Row(KeyboardButton{Text: "a", RequestContact: ptr(true), RequestLocation: ptr(true)}), "both a contact and a location request"},
+		{"false request", new(ReplyKeyboardBuilder).Row(KeyboardButton{Text: "a", RequestContact: ptr(true), RequestLocation: ptr(false)}), ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			markup, err := test.builder.Build()
+			if test.invalid == "" {
+				if err != nil || markup == nil || markup.Keyboard == nil {
+					t.Errorf("Build() = %v, %v, want a keyboard", markup, err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrInvalidKeyboard) || !strings.Contains(err.Error(), test.invalid) || markup != nil {
+				t.Errorf("Build() = %v, %v, want an ErrInvalidKeyboard about %q", markup, err, test.invalid)
+			}
+		})
+	}
+}
+
+func TestKeyboardGrid(t *testing.T) {
+	tests := []struct {
+		buttons, columns int
+		want             []int // Number of buttons of each row
+	}{
+		{0, 3, []int{}},
+		{5, 2, []int{2, 2, 1}},
+		{6, 3, []int{3, 3}},
+		{3, 0, []int{1, 1, 1}},
+		{2, 5, []int{2}},
+	}
+
+	for _, test := range tests {
+		markup, err := new(InlineKeyboardBuilder).Grid(test.columns, callbackButtons(test.buttons)...).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows := []int{}
+		for _, row := range markup.InlineKeyboard {
+			rows = append(rows, len(row))
+		}
+
+		if !reflect.DeepEqual(rows, test.want) {
+			t.Errorf("Grid(%d, %d buttons) = rows of %v, want %v", test.columns, test.buttons, rows, test.want)
+		}
+	}
+
+	markup, err := new(ReplyKeyboardBuilder).Column(textButtons(2)...).Resize().OneTime().Selective().Build()
+	if err != nil || len(markup.Keyboard) != 2 || !*markup.ResizeKeyboard || !*markup.OneTimeKeyboard || !*markup.Selective {
+		t.Errorf("Build() = %+v, %v, want a resized one-time selective column", markup, err)
+	}
+}
+
+func TestKeyboardBuilderCopies(t *testing.T) {
+	inline, reply := callbackButtons(4), textButtons(4)
+	inlineBuilder := new(InlineKeyboardBuilder).Row(inline[:2]...).Grid(2, inline[2:]...)
+	replyBuilder := new(ReplyKeyboardBuilder).Row(reply[:2]...).Grid(2, reply[2:]...)
+
+	// Reusing the buttons of the caller must not change the rows already appended
+	for i := range inline {
+		inline[i], reply[i] = CallbackButton("changed", "changed"), TextButton("changed")
+	}
+
+	inlineBuilder.Row(inline[0])
+	replyBuilder.Row(reply[0])
+
+	inlineMarkup, err := inlineBuilder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replyMarkup, err := replyBuilder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range [][]string{{"0", "1"}, {"2", "3"}, {"changed"}} {
+		for j, text := range want {
+			if got := inlineMarkup.InlineKeyboard[i][j].Text; got != text {
+				t.Errorf("inline button %d,%d = %q, want %q", i, j, got, text)
+			}
+
+			if got := replyMarkup.Keyboard[i][j].Text; got != text {
+				t.Errorf("reply button %d,%d = %q, want %q", i, j, got, text)
+			}
+		}
+	}
+}
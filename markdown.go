@@ -0,0 +1,173 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var markdownEscaper = strings.NewReplacer(`_`, `\_`, `*`, `\*`, "`", "\\`", `[`, `\[`)
+
+// EscapeMarkdown escapes the text to be sent with the Markdown parse mode.
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// RenderMarkdown returns the text with its entities written as Telegram Markdown, e.g. to send a received message again with
+// the Markdown parse mode. Markdown entities can not be nested, so only the outer one of nested entities is written. A bold,
+// italic or code entity containing its own delimiter is closed and opened again around the escaped delimiter, as Telegram
+// recommends, while a pre or link entity which can not be written is written as plain text.
+func RenderMarkdown(text string, entities []MessageEntity) string {
+	result := strings.Builder{}
+	position := 0
+
+	for _, span := range formattingSpans(text, entities) {
+		if span.start < position {
+			continue
+		}
+
+		result.WriteString(EscapeMarkdown(text[position:span.start]))
+		result.WriteString(markdownEntity(span.entity, text[span.start:span.end]))
+		position = span.end
+	}
+
+	result.WriteString(EscapeMarkdown(text[position:]))
+
+	return result.String()
+}
+
+// MessageMarkdown returns the text or the caption of the message with its entities written as Telegram Markdown.
+func MessageMarkdown(message *Message) string {
+	if message.Text != nil {
+		return RenderMarkdown(*message.Text, message.Entities)
+	}
+
+	if message.Caption != nil {
+		return RenderMarkdown(*message.Caption, message.CaptionEntities)
+	}
+
+	return ""
+}
+
+// ParseMarkdown returns the text and the entities of Telegram Markdown, the opposite of RenderMarkdown.
+func ParseMarkdown(s string) (string, []MessageEntity, error) {
+	text := strings.Builder{}
+	units := int64(0)
+	entities := []MessageEntity{}
+
+	write := func(entity MessageEntity, content string) {
+		entity.Offset, entity.Length = units, UTF16Len(content)
+		text.WriteString(content)
+		units += entity.Length
+
+		if entity.Type != "" && entity.Length > 0 {
+			entities = append(entities, entity)
+		}
+	}
+
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && strings.IndexByte("_*`[", s[i+1]) >= 0:
+			write(MessageEntity{}, s[i+1:i+2])
+			i += 2
+		case strings.HasPrefix(s[i:], "```"):
+			end := strings.Index(s[i+3:], "```")
+			if end < 0 {
+				return "", nil, fmt.Errorf("telegram: can't parse Markdown: unclosed pre at byte %d", i)
+			}
+
+			write(MessageEntity{Type: Pre}, s[i+3:i+3+end])
+			i += end + 6
+		case s[i] == '*' || s[i] == '_' || s[i] == '`':
+			end := strings.IndexByte(s[i+1:], s[i])
+			if end < 0 {
+				return "", nil, fmt.Errorf("telegram: can't parse Markdown: unclosed %q at byte %d", s[i], i)
+			}
+
+			entityType := map[byte]string{'*': Bold, '_': Italic, '`': Code}[s[i]]
+			write(MessageEntity{Type: entityType}, s[i+1:i+1+end])
+			i += end + 2
+		case s[i] == '[':
+			textEnd := strings.IndexByte(s[i:], ']')
+			if textEnd < 0 {
+				return "", nil, fmt.Errorf("telegram: can't parse Markdown: unclosed link at byte %d", i)
+			}
+
+			if !strings.HasPrefix(s[i+textEnd:], "](") {
+				// Brackets without a url are plain text, e.g. a footnote: see [1]
+				write(MessageEntity{}, "[")
+				i++
+
+				continue
+			}
+
+			urlEnd := strings.IndexByte(s[i+textEnd+2:], ')')
+			if urlEnd < 0 {
+				return "", nil, fmt.Errorf("telegram: can't parse Markdown: unclosed link url at byte %d", i)
+			}
+
+			url := s[i+textEnd+2 : i+textEnd+2+urlEnd]
+			entity := MessageEntity{Type: TextLink, URL: &url}
+
+			if user, ok := mentionedUser(url); ok {
+				entity = MessageEntity{Type: TextMention, User: user}
+			}
+
+			write(entity, s[i+1:i+textEnd])
+			i += textEnd + urlEnd + 3
+		default:
+			_, size := utf8.DecodeRuneInString(s[i:])
+			write(MessageEntity{}, s[i:i+size])
+			i += size
+		}
+	}
+
+	sortEntities(entities)
+
+	return text.String(), entities, nil
+}
+
+// markdownEntity returns the content of the entity written as Markdown.
+func markdownEntity(entity MessageEntity, content string) string {
+	switch entity.Type {
+	case Bold:
+		return markdownDelimited("*", content)
+	case Italic:
+		return markdownDelimited("_", content)
+	case Code:
+		return markdownDelimited("`", content)
+	case Pre:
+		if !strings.Contains(content, "```") {
+			return "```" + content + "```"
+		}
+	case TextLink:
+		if !strings.Contains(content, "]") && !strings.Contains(*entity.URL, ")") {
+			return "[" + content + "](" + *entity.URL + ")"
+		}
+	case TextMention:
+		if !strings.Contains(content, "]") {
+			return "[" + content + "](" + mentionURL(entity.User) + ")"
+		}
+	}
+
+	return EscapeMarkdown(content)
+}
+
+// markdownDelimited returns the content between the delimiters, closing and opening them again around the escaped delimiters
+// of the content, since there is no escaping inside Markdown entities.
+func markdownDelimited(delimiter, content string) string {
+	parts := strings.Split(content, delimiter)
+	result := strings.Builder{}
+
+	for i, part := range parts {
+		if i > 0 {
+			result.WriteString(`\` + delimiter)
+		}
+
+		if part != "" {
+			result.WriteString(delimiter + part + delimiter)
+		}
+	}
+
+	return result.String()
+}
@@ -0,0 +1,138 @@
+package telegram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	link := "http://example.com/(x)"
+
+	tests := []struct {
+		name     string
+		text     string
+		entities []MessageEntity
+		markdown string
+		parsed   []MessageEntity // Entities parsed from the Markdown, if they differ from the rendered ones
+	}{
+		{
+			name:     "escaping",
+			text:     "a_b*c`d[e]",
+			markdown: "a\\_b\\*c\\`d\\[e]",
+		},
+		{
+			name:     "bold",
+			text:     "a bold b",
+			entities: []MessageEntity{{Type: Bold, Offset: 2, Length: 4}},
+			markdown: "a *bold* b",
+		},
+		{
+			name:     "delimiter in the entity",
+			text:     "a*b",
+			entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 3}},
+			markdown: `*a*\**b*`,
+			parsed:   []MessageEntity{{Type: Bold, Offset: 0, Length: 1}, {Type: Bold, Offset: 2, Length: 1}},
+		},
+		{
+			name:     "other delimiters in the entity",
+			text:     "a_b",
+			entities: []MessageEntity{{Type: Code, Offset: 0, Length: 3}},
+			markdown: "`a_b`",
+		},
+		{
+			name:     "nested",
+			text:     "bold italic",
+			entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 11}, {Type: Italic, Offset: 5, Length: 6}},
+			markdown: "*bold italic*",
+			parsed:   []MessageEntity{{Type: Bold, Offset: 0, Length: 11}},
+		},
+		{
+			name:     "overlapping",
+			text:     "abcdef",
+			entities: []MessageEntity{{Type: Bold, Offset: 0, Length: 4}, {Type: Italic, Offset: 2, Length: 4}},
+			markdown: "*abcd*ef",
+			parsed:   []MessageEntity{{Type: Bold, Offset: 0, Length: 4}},
+		},
+		{
+			name:     "pre",
+			text:     "x := 1",
+			entities: []MessageEntity{{Type: Pre, Offset: 0, Length: 6}},
+			markdown: "```x := 1```",
+		},
+		{
+			name:     "link",
+			text:     "see link",
+			entities: []MessageEntity{{Type: TextLink, Offset: 4, Length: 4, URL: &link}},
+			markdown: "see link",
+			parsed:   []MessageEntity{},
+		},
+		{
+			name:     "mention",
+			text:     "hi user",
+			entities: []MessageEntity{{Type: TextMention, Offset: 3, Length: 4, User: &User{ID: 42}}},
+			markdown: "hi [user](tg://user?id=42)",
+		},
+		{
+			name:     "surrogate pairs",
+			text:     "😀 bold 𝄞 code",
+			entities: []MessageEntity{{Type: Bold, Offset: 3, Length: 4}, {Type: Code, Offset: 8, Length: 7}},
+			markdown: "😀 *bold* `𝄞 code`",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RenderMarkdown(test.text, test.entities); got != test.markdown {
+				t.Fatalf("RenderMarkdown() = %q, want %q", got, test.markdown)
+			}
+
+			text, entities, err := ParseMarkdown(test.markdown)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := test.parsed
+			if want == nil {
+				want = append([]MessageEntity{}, test.entities...)
+			}
+
+			if text != test.text || !reflect.DeepEqual(entities, want) {
+				t.Errorf("ParseMarkdown() = %q, %+v, want %q, %+v", text, entities, test.text, want)
+			}
+		})
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	link := "http://example.com"
+
+	tests := []struct {
+		markdown string
+		text     string
+		entities []MessageEntity
+		err      bool
+	}{
+		{markdown: "_i_ [l](http://example.com)", text: "i l", entities: []MessageEntity{{Type: Italic, Offset: 0, Length: 1}, {Type: TextLink, Offset: 2, Length: 1, URL: &link}}},
+		{markdown: "**x", text: "x", entities: []MessageEntity{}},
+		{markdown: `\_\*`, text: "_*", entities: []MessageEntity{}},
+		{markdown: "see [1] here", text: "see [1] here", entities: []MessageEntity{}},
+		{markdown: "[a] *b* [c](http://example.com)", text: "[a] b c", entities: []MessageEntity{{Type: Bold, Offset: 4, Length: 1}, {Type: TextLink, Offset: 6, Length: 1, URL: &link}}},
+		{markdown: "[[l](http://example.com)", text: "[l", entities: []MessageEntity{{Type: TextLink, Offset: 0, Length: 2, URL: &link}}},
+		{markdown: "*x", err: true},
+		{markdown: "[a](b", err: true},
+		{markdown: "[a", err: true},
+		{markdown: "```x", err: true},
+	}
+
+	for _, test := range tests {
+		text, entities, err := ParseMarkdown(test.markdown)
+		if (err != nil) != test.err {
+			t.Errorf("ParseMarkdown(%q) error = %v, want error %v", test.markdown, err, test.err)
+			continue
+		}
+
+		if !test.err && (text != test.text || !reflect.DeepEqual(entities, test.entities)) {
+			t.Errorf("ParseMarkdown(%q) = %q, %+v, want %q, %+v", test.markdown, text, entities, test.text, test.entities)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hellowearemito/go-telegram-structs/schema"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of testdata")
+
+// methodStructs are the request structs encoded by the golden tests. TestMethodStructsListed checks that none is missing.
+var methodStructs = []Method{
+	SendMessage{},
+	ForwardMessage{},
+	SendPhoto{},
+	SendAudio{},
+	SendDocument{},
+	SendVideo{},
+	SendAnimation{},
+	SendVoice{},
+	SendVideoNote{},
+	SendMediaGroup{},
+	SendLocation{},
+	EditMessageLiveLocation{},
+	StopMessageLiveLocation{},
+	EditMessageText{},
+	EditMessageCaption{},
+	EditMessageReplyMarkup{},
+	EditMessageMedia{},
+	DeleteMessage{},
+	SendVenue{},
+	SendContact{},
+	SendChatAction{},
+	GetFile{},
+	KickChatMember{},
+	UnbanChatMember{},
+	RestrictChatMember{},
+	PromoteChatMember{},
+	ExportChatInviteLink{},
+	SetChatPhoto{},
+	DeleteChatPhoto{},
+	SetChatTitle{},
+	SetChatDescription{},
+	PinChatMessage{},
+	UnpinChatMessage{},
+	LeaveChat{},
+	GetChat{},
+	GetChatAdministrators{},
+	GetChatMembersCount{},
+	GetChatMember{},
+	SetChatStickerSet{},
+	DeleteChatStickerSet{},
+	AnswerCallbackQuery{},
+	GetMe{},
+	GetUserProfilePhotos{},
+	GetUpdates{},
+	SetWebhook{},
+	DeleteWebhook{},
+	GetWebhookInfo{},
+	AnswerInlineQuery{},
+	SendGame{},
+	SetGameScore{},
+	GetGameHighScores{},
+	SendInvoice{},
+	AnswerShippingQuery{},
+	AnswerPreCheckoutQuery{},
+	SendSticker{},
+	GetStickerSet{},
+	UploadStickerFile{},
+	CreateNewStickerSet{},
+	AddStickerToSet{},
+	SetStickerPositionInSet{},
+	DeleteStickerFromSet{},
+	SetPassportDataErrors{},
+}
+
+func TestMethodStructsListed(t *testing.T) {
+	listed := map[string]bool{}
+	for _, m := range methodStructs {
+		listed[m.MethodName()] = true
+	}
+
+	for _, s := range structVersions {
+		if s.Object[0] >= 'a' && s.Object[0] <= 'z' && !listed[s.Object] {
+			t.Errorf("%s is not in methodStructs", s.Name)
+		}
+	}
+}
+
+func TestMarshalMethods(t *testing.T) {
+	s, err := schema.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range methodStructs {
+		method := s.Method(m.MethodName())
+		if method == nil {
+			t.Errorf("%s is not in the schema", m.MethodName())
+			continue
+		}
+
+		required, all := []string{}, []string{}
+		for _, f := range method.Fields {
+			all = append(all, f.Name)
+
+			if f.Required {
+				required = append(required, f.Name)
+			}
+		}
+
+		t.Run(method.Name+"/min", func(t *testing.T) {
+			testMarshal(t, sampleMethod(m, method, true), method.Name+".min.golden", required)
+		})
+
+		t.Run(method.Name+"/max", func(t *testing.T) {
+			testMarshal(t, sampleMethod(m, method, false), method.Name+".max.golden", all)
+		})
+	}
+}
+
+// testMarshal compares the encoding of the request to the golden file, and its keys to the parameters of the method.
+func testMarshal(t *testing.T, request interface{}, golden string, params []string) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(request, "", "\t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got = append(got, '\n')
+	path := filepath.Join("testdata", golden)
+
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(got, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	params = append([]string(nil), params...)
+	sort.Strings(params)
+
+	if strings.Join(keys, ",") != strings.Join(params, ",") {
+		t.Errorf("got parameters %v, want %v", keys, params)
+	}
+}
+
+// sampleMethod returns a copy of the request struct with its required fields set, or all of them if min is false.
+func sampleMethod(m Method, method *schema.Method, min bool) interface{} {
+	v := reflect.New(reflect.TypeOf(m)).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+
+		f := method.Field(name)
+		if f == nil || (min && !f.Required) {
+			continue
+		}
+
+		v.Field(i).Set(sample(v.Field(i).Type(), name, f.Values))
+	}
+
+	return v.Interface()
+}
+
+var (
+	rawMessageType  = reflect.TypeOf(json.RawMessage{})
+	replyMarkupType = reflect.TypeOf((*ReplyMarkup)(nil)).Elem()
+	inputFileType   = reflect.TypeOf((*InputFile)(nil)).Elem()
+	inputMediaType  = reflect.TypeOf((*InputMedia)(nil)).Elem()
+	errorType       = reflect.TypeOf((*PassportElementError)(nil)).Elem()
+)
+
+// sample returns a value of the type for the field of the name: the first of the values of a string, the name of the
+// field for the other strings, and a struct with all of its fields set.
+func sample(t reflect.Type, name string, values []string) reflect.Value {
+	v := reflect.New(t).Elem()
+
+	switch t {
+	case rawMessageType:
+		v.Set(reflect.ValueOf(json.RawMessage(`[{"type":"article"}]`)))
+		return v
+	case replyMarkupType:
+		data := "data"
+		v.Set(reflect.ValueOf(&InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "button", CallbackData: &data}}}}))
+
+		return v
+	case inputFileType:
+		v.Set(reflect.ValueOf(FileID(name)))
+		return v
+	case inputMediaType:
+		v.Set(reflect.ValueOf(InputMediaPhoto{Type: "photo", Media: FileID(name)}))
+		return v
+	case errorType:
+		v.Set(sample(reflect.TypeOf(PassportElementErrorDataField{}), name, nil))
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(t.Elem()))
+		v.Elem().Set(sample(t.Elem(), name, values))
+	case reflect.Slice:
+		v.Set(reflect.Append(v, sample(t.Elem(), name, values)))
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			v.Field(i).Set(sample(t.Field(i).Type, field, nil))
+		}
+	case reflect.String:
+		if len(values) > 0 {
+			v.SetString(values[0])
+		} else {
+			v.SetString(name)
+		}
+	case reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	}
+
+	return v
+}
@@ -0,0 +1,12 @@
+package telegram
+
+// Method is implemented by every request struct of the Bot API.
+type Method interface {
+	MethodName() string // Name of the Bot API method the request struct belongs to, e.g. sendMessage
+}
+
+// TypedMethod is a Method whose successful result is decoded into R.
+type TypedMethod[R any] interface {
+	Method
+	NewResult() *R // Returns a new value to decode the result of the method into
+}
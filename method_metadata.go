@@ -0,0 +1,441 @@
+// Code generated by botapigen from schema/botapi.json. DO NOT EDIT.
+
+package telegram
+
+// The request structs with their result types.
+var (
+	_ TypedMethod[Message]           = SendMessage{}
+	_ TypedMethod[Message]           = ForwardMessage{}
+	_ TypedMethod[Message]           = SendPhoto{}
+	_ TypedMethod[Message]           = SendAudio{}
+	_ TypedMethod[Message]           = SendDocument{}
+	_ TypedMethod[Message]           = SendVideo{}
+	_ TypedMethod[Message]           = SendAnimation{}
+	_ TypedMethod[Message]           = SendVoice{}
+	_ TypedMethod[Message]           = SendVideoNote{}
+	_ TypedMethod[[]Message]         = SendMediaGroup{}
+	_ TypedMethod[Message]           = SendLocation{}
+	_ TypedMethod[EditedMessage]     = EditMessageLiveLocation{}
+	_ TypedMethod[EditedMessage]     = StopMessageLiveLocation{}
+	_ TypedMethod[EditedMessage]     = EditMessageText{}
+	_ TypedMethod[EditedMessage]     = EditMessageCaption{}
+	_ TypedMethod[EditedMessage]     = EditMessageReplyMarkup{}
+	_ TypedMethod[EditedMessage]     = EditMessageMedia{}
+	_ TypedMethod[bool]              = DeleteMessage{}
+	_ TypedMethod[Message]           = SendVenue{}
+	_ TypedMethod[Message]           = SendContact{}
+	_ TypedMethod[bool]              = SendChatAction{}
+	_ TypedMethod[File]              = GetFile{}
+	_ TypedMethod[bool]              = KickChatMember{}
+	_ TypedMethod[bool]              = UnbanChatMember{}
+	_ TypedMethod[bool]              = RestrictChatMember{}
+	_ TypedMethod[bool]              = PromoteChatMember{}
+	_ TypedMethod[string]            = ExportChatInviteLink{}
+	_ TypedMethod[bool]              = SetChatPhoto{}
+	_ TypedMethod[bool]              = DeleteChatPhoto{}
+	_ TypedMethod[bool]              = SetChatTitle{}
+	_ TypedMethod[bool]              = SetChatDescription{}
+	_ TypedMethod[bool]              = PinChatMessage{}
+	_ TypedMethod[bool]              = UnpinChatMessage{}
+	_ TypedMethod[bool]              = LeaveChat{}
+	_ TypedMethod[Chat]              = GetChat{}
+	_ TypedMethod[[]ChatMember]      = GetChatAdministrators{}
+	_ TypedMethod[int64]             = GetChatMembersCount{}
+	_ TypedMethod[ChatMember]        = GetChatMember{}
+	_ TypedMethod[bool]              = SetChatStickerSet{}
+	_ TypedMethod[bool]              = DeleteChatStickerSet{}
+	_ TypedMethod[bool]              = AnswerCallbackQuery{}
+	_ TypedMethod[User]              = GetMe{}
+	_ TypedMethod[UserProfilePhotos] = GetUserProfilePhotos{}
+	_ TypedMethod[[]Update]          = GetUpdates{}
+	_ TypedMethod[bool]              = SetWebhook{}
+	_ TypedMethod[bool]              = DeleteWebhook{}
+	_ TypedMethod[WebhookInfo]       = GetWebhookInfo{}
+	_ TypedMethod[bool]              = AnswerInlineQuery{}
+	_ TypedMethod[Message]           = SendGame{}
+	_ TypedMethod[EditedMessage]     = SetGameScore{}
+	_ TypedMethod[[]GameHighScore]   = GetGameHighScores{}
+	_ TypedMethod[Message]           = SendInvoice{}
+	_ TypedMethod[bool]              = AnswerShippingQuery{}
+	_ TypedMethod[bool]              = AnswerPreCheckoutQuery{}
+	_ TypedMethod[Message]           = SendSticker{}
+	_ TypedMethod[StickerSet]        = GetStickerSet{}
+	_ TypedMethod[File]              = UploadStickerFile{}
+	_ TypedMethod[bool]              = CreateNewStickerSet{}
+	_ TypedMethod[bool]              = AddStickerToSet{}
+	_ TypedMethod[bool]              = SetStickerPositionInSet{}
+	_ TypedMethod[bool]              = DeleteStickerFromSet{}
+	_ TypedMethod[bool]              = SetPassportDataErrors{}
+)
+
+// MethodName returns sendMessage.
+func (SendMessage) MethodName() string { return "sendMessage" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendMessage) NewResult() *Message { return new(Message) }
+
+// MethodName returns forwardMessage.
+func (ForwardMessage) MethodName() string { return "forwardMessage" }
+
+// NewResult returns a new Message to decode the result into.
+func (ForwardMessage) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendPhoto.
+func (SendPhoto) MethodName() string { return "sendPhoto" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendPhoto) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendAudio.
+func (SendAudio) MethodName() string { return "sendAudio" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendAudio) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendDocument.
+func (SendDocument) MethodName() string { return "sendDocument" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendDocument) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendVideo.
+func (SendVideo) MethodName() string { return "sendVideo" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendVideo) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendAnimation.
+func (SendAnimation) MethodName() string { return "sendAnimation" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendAnimation) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendVoice.
+func (SendVoice) MethodName() string { return "sendVoice" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendVoice) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendVideoNote.
+func (SendVideoNote) MethodName() string { return "sendVideoNote" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendVideoNote) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendMediaGroup.
+func (SendMediaGroup) MethodName() string { return "sendMediaGroup" }
+
+// NewResult returns a new []Message to decode the result into.
+func (SendMediaGroup) NewResult() *[]Message { return new([]Message) }
+
+// MethodName returns sendLocation.
+func (SendLocation) MethodName() string { return "sendLocation" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendLocation) NewResult() *Message { return new(Message) }
+
+// MethodName returns editMessageLiveLocation.
+func (EditMessageLiveLocation) MethodName() string { return "editMessageLiveLocation" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (EditMessageLiveLocation) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns stopMessageLiveLocation.
+func (StopMessageLiveLocation) MethodName() string { return "stopMessageLiveLocation" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (StopMessageLiveLocation) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns editMessageText.
+func (EditMessageText) MethodName() string { return "editMessageText" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (EditMessageText) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns editMessageCaption.
+func (EditMessageCaption) MethodName() string { return "editMessageCaption" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (EditMessageCaption) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns editMessageReplyMarkup.
+func (EditMessageReplyMarkup) MethodName() string { return "editMessageReplyMarkup" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (EditMessageReplyMarkup) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns editMessageMedia.
+func (EditMessageMedia) MethodName() string { return "editMessageMedia" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (EditMessageMedia) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns deleteMessage.
+func (DeleteMessage) MethodName() string { return "deleteMessage" }
+
+// NewResult returns a new bool to decode the result into.
+func (DeleteMessage) NewResult() *bool { return new(bool) }
+
+// MethodName returns sendVenue.
+func (SendVenue) MethodName() string { return "sendVenue" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendVenue) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendContact.
+func (SendContact) MethodName() string { return "sendContact" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendContact) NewResult() *Message { return new(Message) }
+
+// MethodName returns sendChatAction.
+func (SendChatAction) MethodName() string { return "sendChatAction" }
+
+// NewResult returns a new bool to decode the result into.
+func (SendChatAction) NewResult() *bool { return new(bool) }
+
+// MethodName returns getFile.
+func (GetFile) MethodName() string { return "getFile" }
+
+// NewResult returns a new File to decode the result into.
+func (GetFile) NewResult() *File { return new(File) }
+
+// MethodName returns kickChatMember.
+func (KickChatMember) MethodName() string { return "kickChatMember" }
+
+// NewResult returns a new bool to decode the result into.
+func (KickChatMember) NewResult() *bool { return new(bool) }
+
+// MethodName returns unbanChatMember.
+func (UnbanChatMember) MethodName() string { return "unbanChatMember" }
+
+// NewResult returns a new bool to decode the result into.
+func (UnbanChatMember) NewResult() *bool { return new(bool) }
+
+// MethodName returns restrictChatMember.
+func (RestrictChatMember) MethodName() string { return "restrictChatMember" }
+
+// NewResult returns a new bool to decode the result into.
+func (RestrictChatMember) NewResult() *bool { return new(bool) }
+
+// MethodName returns promoteChatMember.
+func (PromoteChatMember) MethodName() string { return "promoteChatMember" }
+
+// NewResult returns a new bool to decode the result into.
+func (PromoteChatMember) NewResult() *bool { return new(bool) }
+
+// MethodName returns exportChatInviteLink.
+func (ExportChatInviteLink) MethodName() string { return "exportChatInviteLink" }
+
+// NewResult returns a new string to decode the result into.
+func (ExportChatInviteLink) NewResult() *string { return new(string) }
+
+// MethodName returns setChatPhoto.
+func (SetChatPhoto) MethodName() string { return "setChatPhoto" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetChatPhoto) NewResult() *bool { return new(bool) }
+
+// MethodName returns deleteChatPhoto.
+func (DeleteChatPhoto) MethodName() string { return "deleteChatPhoto" }
+
+// NewResult returns a new bool to decode the result into.
+func (DeleteChatPhoto) NewResult() *bool { return new(bool) }
+
+// MethodName returns setChatTitle.
+func (SetChatTitle) MethodName() string { return "setChatTitle" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetChatTitle) NewResult() *bool { return new(bool) }
+
+// MethodName returns setChatDescription.
+func (SetChatDescription) MethodName() string { return "setChatDescription" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetChatDescription) NewResult() *bool { return new(bool) }
+
+// MethodName returns pinChatMessage.
+func (PinChatMessage) MethodName() string { return "pinChatMessage" }
+
+// NewResult returns a new bool to decode the result into.
+func (PinChatMessage) NewResult() *bool { return new(bool) }
+
+// MethodName returns unpinChatMessage.
+func (UnpinChatMessage) MethodName() string { return "unpinChatMessage" }
+
+// NewResult returns a new bool to decode the result into.
+func (UnpinChatMessage) NewResult() *bool { return new(bool) }
+
+// MethodName returns leaveChat.
+func (LeaveChat) MethodName() string { return "leaveChat" }
+
+// NewResult returns a new bool to decode the result into.
+func (LeaveChat) NewResult() *bool { return new(bool) }
+
+// MethodName returns getChat.
+func (GetChat) MethodName() string { return "getChat" }
+
+// NewResult returns a new Chat to decode the result into.
+func (GetChat) NewResult() *Chat { return new(Chat) }
+
+// MethodName returns getChatAdministrators.
+func (GetChatAdministrators) MethodName() string { return "getChatAdministrators" }
+
+// NewResult returns a new []ChatMember to decode the result into.
+func (GetChatAdministrators) NewResult() *[]ChatMember { return new([]ChatMember) }
+
+// MethodName returns getChatMembersCount.
+func (GetChatMembersCount) MethodName() string { return "getChatMembersCount" }
+
+// NewResult returns a new int64 to decode the result into.
+func (GetChatMembersCount) NewResult() *int64 { return new(int64) }
+
+// MethodName returns getChatMember.
+func (GetChatMember) MethodName() string { return "getChatMember" }
+
+// NewResult returns a new ChatMember to decode the result into.
+func (GetChatMember) NewResult() *ChatMember { return new(ChatMember) }
+
+// MethodName returns setChatStickerSet.
+func (SetChatStickerSet) MethodName() string { return "setChatStickerSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetChatStickerSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns deleteChatStickerSet.
+func (DeleteChatStickerSet) MethodName() string { return "deleteChatStickerSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (DeleteChatStickerSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns answerCallbackQuery.
+func (AnswerCallbackQuery) MethodName() string { return "answerCallbackQuery" }
+
+// NewResult returns a new bool to decode the result into.
+func (AnswerCallbackQuery) NewResult() *bool { return new(bool) }
+
+// MethodName returns getMe.
+func (GetMe) MethodName() string { return "getMe" }
+
+// NewResult returns a new User to decode the result into.
+func (GetMe) NewResult() *User { return new(User) }
+
+// MethodName returns getUserProfilePhotos.
+func (GetUserProfilePhotos) MethodName() string { return "getUserProfilePhotos" }
+
+// NewResult returns a new UserProfilePhotos to decode the result into.
+func (GetUserProfilePhotos) NewResult() *UserProfilePhotos { return new(UserProfilePhotos) }
+
+// MethodName returns getUpdates.
+func (GetUpdates) MethodName() string { return "getUpdates" }
+
+// NewResult returns a new []Update to decode the result into.
+func (GetUpdates) NewResult() *[]Update { return new([]Update) }
+
+// MethodName returns setWebhook.
+func (SetWebhook) MethodName() string { return "setWebhook" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetWebhook) NewResult() *bool { return new(bool) }
+
+// MethodName returns deleteWebhook.
+func (DeleteWebhook) MethodName() string { return "deleteWebhook" }
+
+// NewResult returns a new bool to decode the result into.
+func (DeleteWebhook) NewResult() *bool { return new(bool) }
+
+// MethodName returns getWebhookInfo.
+func (GetWebhookInfo) MethodName() string { return "getWebhookInfo" }
+
+// NewResult returns a new WebhookInfo to decode the result into.
+func (GetWebhookInfo) NewResult() *WebhookInfo { return new(WebhookInfo) }
+
+// MethodName returns answerInlineQuery.
+func (AnswerInlineQuery) MethodName() string { return "answerInlineQuery" }
+
+// NewResult returns a new bool to decode the result into.
+func (AnswerInlineQuery) NewResult() *bool { return new(bool) }
+
+// MethodName returns sendGame.
+func (SendGame) MethodName() string { return "sendGame" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendGame) NewResult() *Message { return new(Message) }
+
+// MethodName returns setGameScore.
+func (SetGameScore) MethodName() string { return "setGameScore" }
+
+// NewResult returns a new EditedMessage to decode the result into.
+func (SetGameScore) NewResult() *EditedMessage { return new(EditedMessage) }
+
+// MethodName returns getGameHighScores.
+func (GetGameHighScores) MethodName() string { return "getGameHighScores" }
+
+// NewResult returns a new []GameHighScore to decode the result into.
+func (GetGameHighScores) NewResult() *[]GameHighScore { return new([]GameHighScore) }
+
+// MethodName returns sendInvoice.
+func (SendInvoice) MethodName() string { return "sendInvoice" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendInvoice) NewResult() *Message { return new(Message) }
+
+// MethodName returns answerShippingQuery.
+func (AnswerShippingQuery) MethodName() string { return "answerShippingQuery" }
+
+// NewResult returns a new bool to decode the result into.
+func (AnswerShippingQuery) NewResult() *bool { return new(bool) }
+
+// MethodName returns answerPreCheckoutQuery.
+func (AnswerPreCheckoutQuery) MethodName() string { return "answerPreCheckoutQuery" }
+
+// NewResult returns a new bool to decode the result into.
+func (AnswerPreCheckoutQuery) NewResult() *bool { return new(bool) }
+
+// MethodName returns sendSticker.
+func (SendSticker) MethodName() string { return "sendSticker" }
+
+// NewResult returns a new Message to decode the result into.
+func (SendSticker) NewResult() *Message { return new(Message) }
+
+// MethodName returns getStickerSet.
+func (GetStickerSet) MethodName() string { return "getStickerSet" }
+
+// NewResult returns a new StickerSet to decode the result into.
+func (GetStickerSet) NewResult() *StickerSet { return new(StickerSet) }
+
+// MethodName returns uploadStickerFile.
+func (UploadStickerFile) MethodName() string { return "uploadStickerFile" }
+
+// NewResult returns a new File to decode the result into.
+func (UploadStickerFile) NewResult() *File { return new(File) }
+
+// MethodName returns createNewStickerSet.
+func (CreateNewStickerSet) MethodName() string { return "createNewStickerSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (CreateNewStickerSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns addStickerToSet.
+func (AddStickerToSet) MethodName() string { return "addStickerToSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (AddStickerToSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns setStickerPositionInSet.
+func (SetStickerPositionInSet) MethodName() string { return "setStickerPositionInSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetStickerPositionInSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns deleteStickerFromSet.
+func (DeleteStickerFromSet) MethodName() string { return "deleteStickerFromSet" }
+
+// NewResult returns a new bool to decode the result into.
+func (DeleteStickerFromSet) NewResult() *bool { return new(bool) }
+
+// MethodName returns setPassportDataErrors.
+func (SetPassportDataErrors) MethodName() string { return "setPassportDataErrors" }
+
+// NewResult returns a new bool to decode the result into.
+func (SetPassportDataErrors) NewResult() *bool { return new(bool) }
@@ -0,0 +1,83 @@
+package telegram
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMethodStructs checks that every exported struct of the *_methods.go files implements Method, whether or not the
+// schema lists it.
+func TestMethodStructs(t *testing.T) {
+	fset := token.NewFileSet()
+
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := []*ast.File{}
+	structs := []string{}
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files = append(files, file)
+
+		if strings.HasSuffix(path, "_methods.go") {
+			structs = append(structs, exportedStructs(file)...)
+		}
+	}
+
+	if len(structs) == 0 {
+		t.Fatal("no structs in the *_methods.go files")
+	}
+
+	config := types.Config{Importer: importer.Default()}
+
+	pkg, err := config.Check("telegram", fset, files, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	method := pkg.Scope().Lookup("Method").Type().Underlying().(*types.Interface)
+
+	for _, name := range structs {
+		if !types.Implements(pkg.Scope().Lookup(name).Type(), method) {
+			t.Errorf("%s does not implement Method", name)
+		}
+	}
+}
+
+// exportedStructs returns the names of the exported struct types of the file.
+func exportedStructs(file *ast.File) []string {
+	names := []string{}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.TypeSpec)
+			if _, ok := spec.Type.(*ast.StructType); ok && spec.Name.IsExported() {
+				names = append(names, spec.Name.Name)
+			}
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,733 @@
+// Code generated by botapigen from schema/botapi.json. DO NOT EDIT.
+
+package telegram
+
+import "regexp"
+
+// The patterns of the parameters.
+var (
+	answerInlineQuerySwitchPmParameterPattern = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+	createNewStickerSetNamePattern            = regexp.MustCompile(`^[A-Za-z](_?[A-Za-z0-9])*_by_[A-Za-z0-9_]+$`)
+)
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendMessage) Validate() error {
+	v := newValidation("sendMessage")
+
+	v.required("chat_id", m.ChatID != "")
+	v.formattedLength("text", m.Text, m.ParseMode, 1, 4096)
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m ForwardMessage) Validate() error {
+	v := newValidation("forwardMessage")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("from_chat_id", m.FromChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendPhoto) Validate() error {
+	v := newValidation("sendPhoto")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("photo", m.Photo != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendAudio) Validate() error {
+	v := newValidation("sendAudio")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("audio", m.Audio != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendDocument) Validate() error {
+	v := newValidation("sendDocument")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("document", m.Document != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendVideo) Validate() error {
+	v := newValidation("sendVideo")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("video", m.Video != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendAnimation) Validate() error {
+	v := newValidation("sendAnimation")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("animation", m.Animation != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendVoice) Validate() error {
+	v := newValidation("sendVoice")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("voice", m.Voice != nil)
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendVideoNote) Validate() error {
+	v := newValidation("sendVideoNote")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("video_note", m.VideoNote != nil)
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendMediaGroup) Validate() error {
+	v := newValidation("sendMediaGroup")
+
+	v.required("chat_id", m.ChatID != "")
+	v.items("media", int64(len(m.Media)), 2, 10)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendLocation) Validate() error {
+	v := newValidation("sendLocation")
+
+	v.required("chat_id", m.ChatID != "")
+	v.between("latitude", m.Latitude, -90, 90)
+	v.between("longitude", m.Longitude, -180, 180)
+
+	if m.LivePeriod != nil {
+		v.between("live_period", float64(*m.LivePeriod), 60, 86400)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m EditMessageLiveLocation) Validate() error {
+	v := newValidation("editMessageLiveLocation")
+
+	v.between("latitude", m.Latitude, -90, 90)
+	v.between("longitude", m.Longitude, -180, 180)
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m StopMessageLiveLocation) Validate() error {
+	v := newValidation("stopMessageLiveLocation")
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m EditMessageText) Validate() error {
+	v := newValidation("editMessageText")
+
+	v.formattedLength("text", m.Text, m.ParseMode, 1, 4096)
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m EditMessageCaption) Validate() error {
+	v := newValidation("editMessageCaption")
+
+	if m.Caption != nil {
+		v.formattedLength("caption", *m.Caption, m.ParseMode, 0, 200)
+	}
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m EditMessageReplyMarkup) Validate() error {
+	v := newValidation("editMessageReplyMarkup")
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m EditMessageMedia) Validate() error {
+	v := newValidation("editMessageMedia")
+
+	v.required("media", m.Media != nil)
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m DeleteMessage) Validate() error {
+	v := newValidation("deleteMessage")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendVenue) Validate() error {
+	v := newValidation("sendVenue")
+
+	v.required("chat_id", m.ChatID != "")
+	v.between("latitude", m.Latitude, -90, 90)
+	v.between("longitude", m.Longitude, -180, 180)
+	v.required("title", m.Title != "")
+	v.required("address", m.Address != "")
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendContact) Validate() error {
+	v := newValidation("sendContact")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("phone_number", m.PhoneNumber != "")
+	v.required("first_name", m.FirstName != "")
+
+	if m.Vcard != nil {
+		v.bytes("vcard", *m.Vcard, 0, 2048)
+	}
+
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendChatAction) Validate() error {
+	v := newValidation("sendChatAction")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("action", m.Action != "")
+	v.oneOf("action", m.Action, "typing", "upload_photo", "record_video", "upload_video", "record_audio", "upload_audio", "upload_document", "find_location", "record_video_note", "upload_video_note")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetFile) Validate() error {
+	v := newValidation("getFile")
+
+	v.required("file_id", m.FileID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m KickChatMember) Validate() error {
+	v := newValidation("kickChatMember")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m UnbanChatMember) Validate() error {
+	v := newValidation("unbanChatMember")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m RestrictChatMember) Validate() error {
+	v := newValidation("restrictChatMember")
+
+	v.required("chat_id", m.ChatID != "")
+	v.implies("can_send_media_messages", m.CanSendMediaMessages, "can_send_messages", m.CanSendMessages)
+	v.implies("can_send_other_messages", m.CanSendOtherMessages, "can_send_media_messages", m.CanSendMediaMessages)
+	v.implies("can_add_web_page_previews", m.CanAddWebPagePreviews, "can_send_media_messages", m.CanSendMediaMessages)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m PromoteChatMember) Validate() error {
+	v := newValidation("promoteChatMember")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m ExportChatInviteLink) Validate() error {
+	v := newValidation("exportChatInviteLink")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetChatPhoto) Validate() error {
+	v := newValidation("setChatPhoto")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("photo", m.Photo != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m DeleteChatPhoto) Validate() error {
+	v := newValidation("deleteChatPhoto")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetChatTitle) Validate() error {
+	v := newValidation("setChatTitle")
+
+	v.required("chat_id", m.ChatID != "")
+	v.length("title", m.Title, 1, 255)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetChatDescription) Validate() error {
+	v := newValidation("setChatDescription")
+
+	v.required("chat_id", m.ChatID != "")
+
+	if m.Description != nil {
+		v.length("description", *m.Description, 0, 255)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m PinChatMessage) Validate() error {
+	v := newValidation("pinChatMessage")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m UnpinChatMessage) Validate() error {
+	v := newValidation("unpinChatMessage")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m LeaveChat) Validate() error {
+	v := newValidation("leaveChat")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetChat) Validate() error {
+	v := newValidation("getChat")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetChatAdministrators) Validate() error {
+	v := newValidation("getChatAdministrators")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetChatMembersCount) Validate() error {
+	v := newValidation("getChatMembersCount")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetChatMember) Validate() error {
+	v := newValidation("getChatMember")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetChatStickerSet) Validate() error {
+	v := newValidation("setChatStickerSet")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("sticker_set_name", m.StickerSetName != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m DeleteChatStickerSet) Validate() error {
+	v := newValidation("deleteChatStickerSet")
+
+	v.required("chat_id", m.ChatID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m AnswerCallbackQuery) Validate() error {
+	v := newValidation("answerCallbackQuery")
+
+	v.required("callback_query_id", m.CallbackQueryID != "")
+
+	if m.Text != nil {
+		v.length("text", *m.Text, 0, 200)
+	}
+
+	if m.CacheTime != nil {
+		v.atLeast("cache_time", float64(*m.CacheTime), 0)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (GetMe) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetUserProfilePhotos) Validate() error {
+	v := newValidation("getUserProfilePhotos")
+
+	if m.Offset != nil {
+		v.atLeast("offset", float64(*m.Offset), 0)
+	}
+
+	if m.Limit != nil {
+		v.between("limit", float64(*m.Limit), 1, 100)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetUpdates) Validate() error {
+	v := newValidation("getUpdates")
+
+	if m.Limit != nil {
+		v.between("limit", float64(*m.Limit), 1, 100)
+	}
+
+	if m.Timeout != nil {
+		v.atLeast("timeout", float64(*m.Timeout), 0)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetWebhook) Validate() error {
+	v := newValidation("setWebhook")
+
+	if m.MaxConnections != nil {
+		v.between("max_connections", float64(*m.MaxConnections), 1, 100)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (DeleteWebhook) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (GetWebhookInfo) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m AnswerInlineQuery) Validate() error {
+	v := newValidation("answerInlineQuery")
+
+	v.required("inline_query_id", m.InlineQueryID != "")
+
+	if m.CacheTime != nil {
+		v.atLeast("cache_time", float64(*m.CacheTime), 0)
+	}
+
+	if m.NextOffset != nil {
+		v.bytes("next_offset", *m.NextOffset, 0, 64)
+	}
+
+	if m.SwitchPmParameter != nil {
+		v.length("switch_pm_parameter", *m.SwitchPmParameter, 1, 64)
+		v.matches("switch_pm_parameter", *m.SwitchPmParameter, answerInlineQuerySwitchPmParameterPattern, "must contain only A-Z, a-z, 0-9, _ and -")
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendGame) Validate() error {
+	v := newValidation("sendGame")
+
+	v.required("game_short_name", m.GameShortName != "")
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetGameScore) Validate() error {
+	v := newValidation("setGameScore")
+
+	v.atLeast("score", float64(m.Score), 0)
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetGameHighScores) Validate() error {
+	v := newValidation("getGameHighScores")
+
+	v.messageAddress(m.ChatID != nil, m.MessageID != nil, m.InlineMessageID != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendInvoice) Validate() error {
+	v := newValidation("sendInvoice")
+
+	v.length("title", m.Title, 1, 32)
+	v.length("description", m.Description, 1, 255)
+	v.bytes("payload", m.Payload, 1, 128)
+	v.required("provider_token", m.ProviderToken != "")
+	v.required("start_parameter", m.StartParameter != "")
+	v.required("currency", m.Currency != "")
+	v.items("prices", int64(len(m.Prices)), 1, 0)
+
+	if m.ReplyMarkup != nil {
+		v.markup("reply_markup", *m.ReplyMarkup)
+	}
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m AnswerShippingQuery) Validate() error {
+	v := newValidation("answerShippingQuery")
+
+	v.required("shipping_query_id", m.ShippingQueryID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m AnswerPreCheckoutQuery) Validate() error {
+	v := newValidation("answerPreCheckoutQuery")
+
+	v.required("pre_checkout_query_id", m.PreCheckoutQueryID != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SendSticker) Validate() error {
+	v := newValidation("sendSticker")
+
+	v.required("chat_id", m.ChatID != "")
+	v.required("sticker", m.Sticker != nil)
+	v.markup("reply_markup", m.ReplyMarkup)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m GetStickerSet) Validate() error {
+	v := newValidation("getStickerSet")
+
+	v.required("name", m.Name != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m UploadStickerFile) Validate() error {
+	v := newValidation("uploadStickerFile")
+
+	v.required("png_sticker", m.PngSticker != nil)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m CreateNewStickerSet) Validate() error {
+	v := newValidation("createNewStickerSet")
+
+	v.length("name", m.Name, 1, 64)
+	v.matches("name", m.Name, createNewStickerSetNamePattern, "must begin with a letter, contain only english letters, digits and single underscores, and end in _by_<bot username>")
+	v.length("title", m.Title, 1, 64)
+	v.required("png_sticker", m.PngSticker != nil)
+	v.required("emojis", m.Emojis != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m AddStickerToSet) Validate() error {
+	v := newValidation("addStickerToSet")
+
+	v.required("name", m.Name != "")
+	v.required("png_sticker", m.PngSticker != nil)
+	v.required("emojis", m.Emojis != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetStickerPositionInSet) Validate() error {
+	v := newValidation("setStickerPositionInSet")
+
+	v.required("sticker", m.Sticker != "")
+	v.atLeast("position", float64(m.Position), 0)
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m DeleteStickerFromSet) Validate() error {
+	v := newValidation("deleteStickerFromSet")
+
+	v.required("sticker", m.Sticker != "")
+
+	return v.result()
+}
+
+// Validate checks the request against the constraints of the Bot API.
+func (m SetPassportDataErrors) Validate() error {
+	v := newValidation("setPassportDataErrors")
+
+	v.items("errors", int64(len(m.Errors)), 1, 0)
+
+	return v.result()
+}
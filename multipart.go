@@ -0,0 +1,348 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// requestParam is a parameter of a request, named after the json tag of its field.
+type requestParam struct {
+	name  string
+	value interface{} // Original value of the field
+	plain interface{} // Value of the field without pointers and interfaces
+}
+
+// EncodeRequest encodes the request of the method and returns its body and content type. A request uploading a FileReader or
+// a FilePath is streamed as multipart/form-data, without buffering the files, any other request is encoded as JSON.
+// The files and thumbnails of an InputMedia are attached using the attach://<file_attach_name> convention.
+// The multipart body is an *io.PipeReader, which must be read to the end or closed.
+func EncodeRequest(method Method) (io.Reader, string, error) {
+	request, attachments, err := attachMedia(method)
+	if err != nil {
+		return nil, "", encodingError(method, err)
+	}
+
+	params := append(requestParams(request), attachments...)
+
+	if !hasUpload(params) {
+		body, err := json.Marshal(request)
+		if err != nil {
+			return nil, "", encodingError(method, err)
+		}
+
+		return bytes.NewReader(body), "application/json", nil
+	}
+
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+
+	go func() {
+		err := writeForm(form, params)
+		if err != nil {
+			err = encodingError(method, err)
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return reader, form.FormDataContentType(), nil
+}
+
+// encodingError returns the error of encoding the request of the method.
+func encodingError(method Method, err error) error {
+	return fmt.Errorf("telegram: encoding %s: %w", method.MethodName(), err)
+}
+
+// attachMedia returns a copy of the request with its media and media groups checked and their files to upload replaced by
+// attach:// urls, along with the parameters of the attached files.
+func attachMedia(method Method) (Method, []requestParam, error) {
+	value := reflect.Indirect(reflect.ValueOf(method))
+	if value.Kind() != reflect.Struct {
+		return method, nil, nil
+	}
+
+	mediaType := reflect.TypeOf((*InputMedia)(nil)).Elem()
+	mediaGroupType := reflect.TypeOf([]InputMedia(nil))
+	request := reflect.Value{}
+	attachments := []requestParam{}
+
+	for i := 0; i < value.NumField(); i++ {
+		fieldType := value.Type().Field(i).Type
+		if (fieldType != mediaType && fieldType != mediaGroupType) || value.Field(i).IsNil() {
+			continue
+		}
+
+		if !request.IsValid() {
+			request = reflect.New(value.Type()).Elem()
+			request.Set(value)
+		}
+
+		name := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		if fieldType == mediaType {
+			media, files, err := prepareMedia(name, name+"_file", value.Field(i).Interface())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			request.Field(i).Set(reflect.ValueOf(&media).Elem())
+			attachments = append(attachments, files...)
+
+			continue
+		}
+
+		media, files, err := prepareMediaGroup(name, value.Field(i).Interface().([]InputMedia))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.Field(i).Set(reflect.ValueOf(media))
+		attachments = append(attachments, files...)
+	}
+
+	if !request.IsValid() {
+		return method, nil, nil
+	}
+
+	return request.Interface().(Method), attachments, nil
+}
+
+// mediaTypes are the values of the Type field of the InputMedia structs.
+var mediaTypes = map[reflect.Type]string{
+	reflect.TypeOf(InputMediaAnimation{}): AnimationType,
+	reflect.TypeOf(InputMediaAudio{}):     AudioType,
+	reflect.TypeOf(InputMediaDocument{}):  DocumentType,
+	reflect.TypeOf(InputMediaPhoto{}):     PhotoType,
+	reflect.TypeOf(InputMediaVideo{}):     VideoType,
+}
+
+// prepareMediaGroup checks the rules of an album and returns a copy of its media, with their types set and their files to upload
+// replaced by attach:// urls, along with the parameters of the attached files. The files are named after the parameter and their index.
+func prepareMediaGroup(name string, media []InputMedia) ([]InputMedia, []requestParam, error) {
+	if len(media) < 2 || len(media) > 10 {
+		return nil, nil, fmt.Errorf("%s must include 2-10 items, got %d", name, len(media))
+	}
+
+	prepared := make([]InputMedia, len(media))
+	attachments := []requestParam{}
+
+	for i, item := range media {
+		switch item.(type) {
+		case InputMediaPhoto, *InputMediaPhoto, InputMediaVideo, *InputMediaVideo:
+		default:
+			return nil, nil, fmt.Errorf("%s can include only photos and videos, got %T", name, item)
+		}
+
+		item, files, err := prepareMedia(name, fmt.Sprintf("%s%d", name, i), item)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		prepared[i] = item
+		attachments = append(attachments, files...)
+	}
+
+	return prepared, attachments, nil
+}
+
+// prepareMedia returns a copy of the media of the parameter with the given name, with its type set and its file and
+// thumbnail to upload replaced by attach:// urls, along with the parameters of the attached files. The thumbnail is
+// named after the file, with a _thumb suffix.
+func prepareMedia(name, attachName string, media InputMedia) (InputMedia, []requestParam, error) {
+	value := reflect.Indirect(reflect.ValueOf(media))
+	if !value.IsValid() || mediaTypes[value.Type()] == "" {
+		return nil, nil, fmt.Errorf("%s must be an InputMediaAnimation, InputMediaAudio, InputMediaDocument, InputMediaPhoto or InputMediaVideo, got %T", name, media)
+	}
+
+	prepared := reflect.New(value.Type()).Elem()
+	prepared.Set(value)
+	prepared.FieldByName("Type").SetString(mediaTypes[value.Type()])
+
+	attachments := []requestParam{}
+
+	for _, f := range []struct{ field, attachName string }{{"Media", attachName}, {"Thumb", attachName + "_thumb"}} {
+		field := prepared.FieldByName(f.field)
+		if !field.IsValid() {
+			continue
+		}
+
+		file, _ := field.Interface().(InputFile)
+
+		file, param := attachFile(f.attachName, file)
+		if param != nil {
+			attachments = append(attachments, *param)
+		}
+
+		field.Set(reflect.ValueOf(&file).Elem())
+	}
+
+	return prepared.Interface(), attachments, nil
+}
+
+// attachFile returns the attach:// url and the parameter of a file to upload, or the file itself if it is not uploaded.
+func attachFile(name string, file InputFile) (InputFile, *requestParam) {
+	plain, ok := plainValue(reflect.ValueOf(&file).Elem())
+	if !ok || !isUpload(plain) {
+		return file, nil
+	}
+
+	return "attach://" + name, &requestParam{name: name, value: file, plain: plain}
+}
+
+// requestParams returns the parameters of the request which are set.
+func requestParams(method Method) []requestParam {
+	value := reflect.Indirect(reflect.ValueOf(method))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	params := []requestParam{}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tag = strings.Split(tag, ",")[0]
+			if tag == "-" {
+				continue
+			}
+
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		plain, ok := plainValue(value.Field(i))
+		if !ok {
+			continue
+		}
+
+		params = append(params, requestParam{
+			name:  name,
+			value: value.Field(i).Interface(),
+			plain: plain,
+		})
+	}
+
+	return params
+}
+
+// plainValue returns the value behind the pointers and interfaces of v, or false if one of them is nil.
+func plainValue(v reflect.Value) (interface{}, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		return nil, false
+	}
+
+	return v.Interface(), true
+}
+
+// isUpload reports whether the value is a file that must be uploaded using multipart/form-data.
+func isUpload(value interface{}) bool {
+	switch value.(type) {
+	case FileReader, FilePath:
+		return true
+	}
+
+	return false
+}
+
+// hasUpload reports whether one of the parameters is a file to upload.
+func hasUpload(params []requestParam) bool {
+	for _, param := range params {
+		if isUpload(param.plain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeForm writes the parameters to the multipart form and closes it.
+func writeForm(form *multipart.Writer, params []requestParam) error {
+	for _, param := range params {
+		var err error
+
+		if isUpload(param.plain) {
+			err = writeFile(form, param.name, param.plain)
+		} else {
+			err = writeField(form, param)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return form.Close()
+}
+
+// writeField writes a parameter to the form. Strings are written as they are, the other values as JSON.
+func writeField(form *multipart.Writer, param requestParam) error {
+	if value := reflect.ValueOf(param.plain); value.Kind() == reflect.String {
+		return form.WriteField(param.name, value.String())
+	}
+
+	data, err := json.Marshal(param.value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", param.name, err)
+	}
+
+	return form.WriteField(param.name, string(data))
+}
+
+// writeFile copies a FileReader or a FilePath to the form.
+func writeFile(form *multipart.Writer, name string, file interface{}) error {
+	var (
+		filename string
+		reader   io.Reader
+	)
+
+	switch file := file.(type) {
+	case FileReader:
+		filename, reader = file.Name, file.Reader
+	case FilePath:
+		f, err := os.Open(string(file))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		filename, reader = filepath.Base(string(file)), f
+	}
+
+	if filename == "" {
+		filename = name
+	}
+
+	if reader == nil {
+		return fmt.Errorf("%s: no reader to upload", name)
+	}
+
+	part, err := form.CreateFormFile(name, filename)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, reader)
+
+	return err
+}
@@ -0,0 +1,378 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readForm encodes the request and returns the fields and the uploaded files of its multipart form.
+func readForm(t *testing.T, method Method) (map[string]string, map[string]string) {
+	t.Helper()
+
+	body, contentType, err := EncodeRequest(method)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("content type %q, want multipart/form-data", contentType)
+	}
+
+	fields, files := map[string]string{}, map[string]string{}
+	reader := multipart.NewReader(body, params["boundary"])
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if part.FileName() != "" {
+			files[part.FormName()+" "+part.FileName()] = string(data)
+		} else {
+			fields[part.FormName()] = string(data)
+		}
+	}
+
+	return fields, files
+}
+
+// countingReader is a reader of a number of bytes, counting the calls of Read.
+type countingReader struct {
+	size  int
+	reads int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.reads++
+
+	if r.size == 0 {
+		return 0, io.EOF
+	}
+
+	n := min(len(p), r.size)
+	r.size -= n
+
+	return n, nil
+}
+
+func TestEncodeRequestUpload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(path, []byte("PDF"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	caption := "c"
+
+	tests := []struct {
+		name   string
+		method Method
+		fields map[string]string
+		files  map[string]string // Contents of the files by form and file name
+	}{
+		{
+			"reader",
+			SendPhoto{ChatID: "1", Photo: FileReader{Name: "a.jpg", Reader: strings.NewReader("JPEG")}, Caption: &caption},
+			map[string]string{"chat_id": "1", "caption": "c"},
+			map[string]string{"photo a.jpg": "JPEG"},
+		},
+		{
+			"unnamed reader",
+			SendPhoto{ChatID: "1", Photo: FileReader{Reader: strings.NewReader("JPEG")}},
+			map[string]string{"chat_id": "1"},
+			map[string]string{"photo photo": "JPEG"},
+		},
+		{
+			"path",
+			&SendDocument{ChatID: "@channel", Document: FilePath(path)},
+			map[string]string{"chat_id": "@channel"},
+			map[string]string{"document report.pdf": "PDF"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields, files := readForm(t, test.method)
+
+			if !reflect.DeepEqual(fields, test.fields) {
+				t.Errorf("fields = %v, want %v", fields, test.fields)
+			}
+
+			if !reflect.DeepEqual(files, test.files) {
+				t.Errorf("files = %v, want %v", files, test.files)
+			}
+		})
+	}
+}
+
+func TestEncodeRequestStreams(t *testing.T) {
+	file := &countingReader{size: 10 << 20}
+
+	body, _, err := EncodeRequest(SendDocument{ChatID: "1", Document: FileReader{Name: "a.bin", Reader: file}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := body.(*io.PipeReader); !ok {
+		t.Fatalf("body is a %T, want an *io.PipeReader", body)
+	}
+
+	if file.reads != 0 {
+		t.Errorf("the file was read %d times before the body", file.reads)
+	}
+
+	n, err := io.Copy(io.Discard, body)
+	if err != nil || n < 10<<20 {
+		t.Errorf("read %d bytes, %v, want the whole file", n, err)
+	}
+}
+
+func TestEncodeRequestJSON(t *testing.T) {
+	for _, method := range []Method{
+		SendPhoto{ChatID: "1", Photo: FileID("AgADBAAD")},
+		SendPhoto{ChatID: "1", Photo: FileURL("https://example.com/a.jpg")},
+		&SendDocument{ChatID: "1", Document: "AgADBAAD"},
+		SendMessage{ChatID: "1", Text: "hi"},
+	} {
+		body, contentType, err := EncodeRequest(method)
+		if err != nil || contentType != "application/json" {
+			t.Errorf("EncodeRequest(%T) = %q, %v, want JSON", method, contentType, err)
+			continue
+		}
+
+		want, err := json.Marshal(method)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got, _ := io.ReadAll(body); !bytes.Equal(got, want) {
+			t.Errorf("EncodeRequest(%T) = %s, want %s", method, got, want)
+		}
+	}
+}
+
+func TestEncodeRequestErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		method Method
+		err    error // Error of reading the body, nil for any error
+	}{
+		{"missing path", SendPhoto{ChatID: "1", Photo: FilePath(filepath.Join(t.TempDir(), "missing.jpg"))}, fs.ErrNotExist},
+		{"no reader", SendPhoto{ChatID: "1", Photo: FileReader{Name: "a.jpg"}}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, _, err := EncodeRequest(test.method)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = io.Copy(io.Discard, body)
+			if err == nil || (test.err != nil && !errors.Is(err, test.err)) {
+				t.Errorf("reading the body = %v, want %v", err, test.err)
+			}
+		})
+	}
+
+	if _, err := json.Marshal(SendPhoto{ChatID: "1", Photo: FilePath("a.jpg")}); err == nil {
+		t.Error("json.Marshal() of a FilePath did not fail")
+	}
+}
+
+func TestEncodeMediaGroup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "c.mp4")
+	if err := os.WriteFile(path, []byte("MP4"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	media := []InputMedia{
+		InputMediaPhoto{Media: FileReader{Name: "a.jpg", Reader: strings.NewReader("JPEG")}},
+		&InputMediaVideo{Media: FileID("video")},
+		InputMediaVideo{Media: FilePath(path)},
+		&InputMediaPhoto{Media: "https://example.com/d.jpg"},
+	}
+
+	fields, files := readForm(t, SendMediaGroup{ChatID: "1", Media: media})
+
+	items := []map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields["media"]), &items); err != nil {
+		t.Fatal(err)
+	}
+
+	got := []string{}
+	for _, item := range items {
+		got = append(got, item["type"].(string)+" "+item["media"].(string))
+	}
+
+	if want := []string{"photo attach://media0", "video video", "video attach://media2", "photo https://example.com/d.jpg"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("media = %q, want %q", got, want)
+	}
+
+	if want := map[string]string{"media0 a.jpg": "JPEG", "media2 c.mp4": "MP4"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("files = %v, want %v", files, want)
+	}
+
+	if media[0].(InputMediaPhoto).Type != "" || media[1].(*InputMediaVideo).Type != "" {
+		t.Error("EncodeRequest() changed the media of the request")
+	}
+}
+
+func TestEncodeMediaGroupAttachNames(t *testing.T) {
+	media := make([]InputMedia, 10)
+	for i := range media {
+		media[i] = InputMediaPhoto{Media: FileReader{Name: "a.jpg", Reader: strings.NewReader("JPEG")}}
+	}
+
+	fields, files := readForm(t, SendMediaGroup{ChatID: "1", Media: media})
+
+	items := []map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields["media"]), &items); err != nil {
+		t.Fatal(err)
+	}
+
+	names := map[string]bool{}
+	for _, item := range items {
+		name := strings.TrimPrefix(item["media"].(string), "attach://")
+		if names[name] {
+			t.Errorf("attach name %s is used twice", name)
+		}
+
+		names[name] = true
+
+		if _, ok := files[name+" a.jpg"]; !ok {
+			t.Errorf("no file attached as %s", name)
+		}
+	}
+
+	if len(names) != 10 || len(files) != 10 {
+		t.Errorf("%d attach names and %d files, want 10", len(names), len(files))
+	}
+}
+
+func TestEncodeMediaGroupRules(t *testing.T) {
+	photos := func(n int) []InputMedia {
+		media := make([]InputMedia, n)
+		for i := range media {
+			media[i] = InputMediaPhoto{Media: FileID("photo")}
+		}
+
+		return media
+	}
+
+	tests := []struct {
+		name  string
+		media []InputMedia
+		err   string // Part of the error, empty if the album is valid
+	}{
+		{"empty", photos(0), "must include 2-10 items, got 0"},
+		{"one item", photos(1), "must include 2-10 items, got 1"},
+		{"two items", photos(2), ""},
+		{"ten items", photos(10), ""},
+		{"eleven items", photos(11), "must include 2-10 items, got 11"},
+		{"not a photo or video", append(photos(1), "video"), "can include only photos and videos, got string"},
+		{"document", append(photos(1), InputMediaDocument{Media: FileID("d")}), "can include only photos and videos, got telegram.InputMediaDocument"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := EncodeRequest(SendMediaGroup{ChatID: "1", Media: test.media})
+			if test.err == "" {
+				if err != nil {
+					t.Errorf("EncodeRequest() = %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("EncodeRequest() = %v, want an error about %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestEncodeMediaThumb(t *testing.T) {
+	fields, files := readForm(t, EditMessageMedia{InlineMessageID: ptr("m"), Media: &InputMediaAnimation{
+		Media: FileReader{Name: "a.mp4", Reader: strings.NewReader("MP4")},
+		Thumb: FileReader{Name: "a.jpg", Reader: strings.NewReader("JPEG")},
+	}})
+
+	media := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields["media"]), &media); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"type": "animation", "media": "attach://media_file", "thumb": "attach://media_file_thumb"}
+	if !reflect.DeepEqual(media, want) {
+		t.Errorf("media = %s, want %v", fields["media"], want)
+	}
+
+	if want := map[string]string{"media_file a.mp4": "MP4", "media_file_thumb a.jpg": "JPEG"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("files = %v, want %v", files, want)
+	}
+
+	fields, files = readForm(t, SendMediaGroup{ChatID: "1", Media: []InputMedia{
+		InputMediaPhoto{Media: FileID("photo")},
+		&InputMediaVideo{Media: FileID("video"), Thumb: FileReader{Name: "b.jpg", Reader: strings.NewReader("THUMB")}},
+	}})
+
+	if want := `[{"type":"photo","media":"photo"},{"type":"video","media":"video","thumb":"attach://media1_thumb"}]`; fields["media"] != want {
+		t.Errorf("media = %s, want %s", fields["media"], want)
+	}
+
+	if want := map[string]string{"media1_thumb b.jpg": "THUMB"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("files = %v, want %v", files, want)
+	}
+}
+
+func TestEncodeMediaTypes(t *testing.T) {
+	tests := []struct {
+		media InputMedia
+		want  string
+	}{
+		{InputMediaAnimation{}, AnimationType},
+		{&InputMediaAudio{}, AudioType},
+		{InputMediaDocument{Type: "photo"}, DocumentType},
+		{&InputMediaPhoto{}, PhotoType},
+		{InputMediaVideo{}, VideoType},
+	}
+
+	for _, test := range tests {
+		media, files, err := prepareMedia("media", "media_file", test.media)
+		if err != nil || len(files) != 0 {
+			t.Errorf("prepareMedia(%T) = %v, %v", test.media, files, err)
+			continue
+		}
+
+		if got := reflect.ValueOf(media).FieldByName("Type").String(); got != test.want {
+			t.Errorf("prepareMedia(%T) type = %q, want %q", test.media, got, test.want)
+		}
+	}
+
+	for _, media := range []InputMedia{nil, (*InputMediaPhoto)(nil), "photo"} {
+		if _, _, err := prepareMedia("media", "media_file", media); err == nil {
+			t.Errorf("prepareMedia(%#v) did not fail", media)
+		}
+	}
+}
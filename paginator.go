@@ -0,0 +1,198 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the number of items on a page of a Paginator without a PageSize.
+const DefaultPageSize = 10
+
+// Paginator shows a list of items as inline keyboard buttons, a page at a time, with buttons to flip the pages which edit
+// the message in place. It can be registered to a Router with router.OnCallbackQuery(paginator.Filter(), paginator.HandleCallbackQuery).
+type Paginator struct {
+	Client    *Client                                                                           // Client to edit the messages and answer the callback queries with
+	Prefix    string                                                                            // Prefix of the callback data of the navigation buttons, unique among the paginators of the bot, e.g. "products"
+	Items     func(ctx context.Context, offset, limit int) ([]InlineKeyboardButton, int, error) // Returns the buttons of the items of a page, and the total number of items
+	PageSize  int                                                                               // Optional. Number of items on a page, DefaultPageSize if zero
+	Columns   int                                                                               // Optional. Number of columns of the item buttons, 1 if zero
+	Text      func(page, pages int) string                                                      // Optional. Returns the text of the message of a page, from 1. Defaults to "Page 1/N".
+	ParseMode *string                                                                           // Optional. Parse mode of the text of the message
+	KeepText  bool                                                                              // Optional. Only edit the keyboard when flipping the pages, keeping the text of the message
+	Previous  string                                                                            // Optional. Label of the previous page button, "◀" if empty
+	Next      string                                                                            // Optional. Label of the next page button, "▶" if empty
+}
+
+// Filter matches the callback queries of the navigation buttons of the paginator.
+func (p *Paginator) Filter() CallbackQueryFilter {
+	return CallbackDataPrefix(p.Prefix + ":")
+}
+
+// Message returns the message showing the page, from 0, to send to the chat.
+func (p *Paginator) Message(ctx context.Context, chatID string, page int) (*SendMessage, error) {
+	markup, page, pages, err := p.render(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SendMessage{
+		ChatID:      chatID,
+		Text:        p.text(page, pages),
+		ParseMode:   p.ParseMode,
+		ReplyMarkup: markup,
+	}, nil
+}
+
+// Markup returns the inline keyboard of the page, from 0, e.g. to show the first page in an inline query result.
+func (p *Paginator) Markup(ctx context.Context, page int) (*InlineKeyboardMarkup, error) {
+	markup, _, _, err := p.render(ctx, page)
+	return markup, err
+}
+
+// HandleCallbackQuery flips the page of the message of a navigation button, sent by the bot or in inline mode, and answers
+// the callback query. The query is answered even if the message can not be edited, e.g. when Telegram rejects an edit
+// leaving it unchanged, and the error of the edit is returned along with the error of the answer.
+func (p *Paginator) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) (err error) {
+	defer func() {
+		_, answerErr := Execute[bool](ctx, p.Client, AnswerCallbackQuery{CallbackQueryID: query.ID})
+		err = errors.Join(err, answerErr)
+	}()
+
+	data := strings.TrimPrefix(query.Data, p.Prefix+":")
+	if data == "" {
+		return nil
+	}
+
+	page, err := strconv.Atoi(data)
+	if err != nil {
+		return fmt.Errorf("telegram: invalid paginator callback data %q", query.Data)
+	}
+
+	return p.edit(ctx, query, page)
+}
+
+// edit edits the message of the callback query to show the page.
+func (p *Paginator) edit(ctx context.Context, query *CallbackQuery, page int) error {
+	markup, page, pages, err := p.render(ctx, page)
+	if err != nil {
+		return err
+	}
+
+	var chatID, inlineMessageID *string
+	var messageID *int64
+
+	switch {
+	case query.InlineMessageID != "":
+		inlineMessageID = &query.InlineMessageID
+	case query.Message != nil:
+		id := strconv.FormatInt(query.Message.Chat.ID, 10)
+		chatID, messageID = &id, &query.Message.MessageID
+	default:
+		return fmt.Errorf("telegram: callback query %s has no message to edit", query.ID)
+	}
+
+	if p.KeepText {
+		_, err = Execute[EditedMessage](ctx, p.Client, EditMessageReplyMarkup{
+			ChatID:          chatID,
+			MessageID:       messageID,
+			InlineMessageID: inlineMessageID,
+			ReplyMarkup:     markup,
+		})
+
+		return err
+	}
+
+	_, err = Execute[EditedMessage](ctx, p.Client, EditMessageText{
+		ChatID:          chatID,
+		MessageID:       messageID,
+		InlineMessageID: inlineMessageID,
+		Text:            p.text(page, pages),
+		ParseMode:       p.ParseMode,
+		ReplyMarkup:     markup,
+	})
+
+	return err
+}
+
+// render returns the inline keyboard of the page, along with the page, moved to the last one if it is out of the list, and
+// the number of pages.
+func (p *Paginator) render(ctx context.Context, page int) (*InlineKeyboardMarkup, int, int, error) {
+	size := p.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	if page < 0 {
+		page = 0
+	}
+
+	items, total, err := p.Items(ctx, page*size, size)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	pages := (total + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+
+	if page >= pages {
+		page = pages - 1
+
+		items, total, err = p.Items(ctx, page*size, size)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+	}
+
+	builder := new(InlineKeyboardBuilder).Grid(p.Columns, items...)
+
+	if pages > 1 {
+		navigation := []InlineKeyboardButton{}
+
+		if page > 0 {
+			navigation = append(navigation, CallbackButton(defaultString(p.Previous, "◀"), p.callbackData(page-1)))
+		}
+
+		navigation = append(navigation, CallbackButton(fmt.Sprintf("%d/%d", page+1, pages), p.Prefix+":"))
+
+		if page < pages-1 {
+			navigation = append(navigation, CallbackButton(defaultString(p.Next, "▶"), p.callbackData(page+1)))
+		}
+
+		builder.Row(navigation...)
+	}
+
+	markup, err := builder.Build()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return markup, page, pages, nil
+}
+
+// text returns the text of the message of the page.
+func (p *Paginator) text(page, pages int) string {
+	if p.Text != nil {
+		return p.Text(page+1, pages)
+	}
+
+	return fmt.Sprintf("Page %d/%d", page+1, pages)
+}
+
+// callbackData returns the callback data of the button flipping to the page.
+func (p *Paginator) callbackData(page int) string {
+	return p.Prefix + ":" + strconv.Itoa(page)
+}
+
+// defaultString returns the value, or the default value if it is empty.
+func defaultString(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,196 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testItems returns the buttons of 25 items.
+func testItems(ctx context.Context, offset, limit int) ([]InlineKeyboardButton, int, error) {
+	buttons := []InlineKeyboardButton{}
+	for i := offset; i < offset+limit && i < 25; i++ {
+		buttons = append(buttons, CallbackButton(fmt.Sprint("item ", i), fmt.Sprint("item:", i)))
+	}
+
+	return buttons, 25, nil
+}
+
+// buttonData returns the callback data of the buttons of the row.
+func buttonData(row []InlineKeyboardButton) []string {
+	data := []string{}
+	for _, button := range row {
+		data = append(data, *button.CallbackData)
+	}
+
+	return data
+}
+
+func TestPaginatorMessage(t *testing.T) {
+	p := &Paginator{Prefix: "p", Items: testItems, Columns: 5}
+
+	tests := []struct {
+		page       int
+		text       string
+		rows       int
+		navigation []string
+	}{
+		{0, "Page 1/3", 3, []string{"p:", "p:1"}},
+		{1, "Page 2/3", 3, []string{"p:0", "p:", "p:2"}},
+		{9, "Page 3/3", 2, []string{"p:1", "p:"}},
+		{-1, "Page 1/3", 3, []string{"p:", "p:1"}},
+	}
+
+	for _, test := range tests {
+		message, err := p.Message(context.Background(), "1", test.page)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		markup := message.ReplyMarkup.(*InlineKeyboardMarkup)
+		rows := markup.InlineKeyboard
+
+		if message.Text != test.text || len(rows) != test.rows {
+			t.Errorf("Message(%d) = %q with %d rows, want %q with %d", test.page, message.Text, len(rows), test.text, test.rows)
+			continue
+		}
+
+		if got := buttonData(rows[len(rows)-1]); !reflect.DeepEqual(got, test.navigation) {
+			t.Errorf("Message(%d) navigation = %v, want %v", test.page, got, test.navigation)
+		}
+	}
+}
+
+// testBotAPI is a test server of the requests of a paginator. It responds with the errors of the methods, and with
+// true to the other requests.
+type testBotAPI struct {
+	errors   map[string]string // Responses of the failing methods
+	requests []string          // Received requests, as method and body
+}
+
+func (b *testBotAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	method := path.Base(r.URL.Path)
+	b.requests = append(b.requests, method+" "+string(body))
+
+	if response, ok := b.errors[method]; ok {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, response)
+
+		return
+	}
+
+	io.WriteString(w, `{"ok":true,"result":true}`)
+}
+
+func TestPaginatorHandleCallbackQuery(t *testing.T) {
+	const notModified = `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`
+	const tooOld = `{"ok":false,"error_code":400,"description":"Bad Request: query is too old and response timeout expired"}`
+
+	chatMessage := &Message{MessageID: 7, Chat: Chat{ID: -5}}
+	answer := `answerCallbackQuery {"callback_query_id":"q"}`
+
+	tests := []struct {
+		name     string
+		query    CallbackQuery
+		keepText bool
+		errors   map[string]string
+		requests []string
+		failures int // Number of errors joined in the returned error
+	}{
+		{
+			name:     "inline message",
+			query:    CallbackQuery{ID: "q", Data: "p:1", InlineMessageID: "m"},
+			requests: []string{"editMessageText", answer},
+		},
+		{
+			name:     "keyboard only",
+			query:    CallbackQuery{ID: "q", Data: "p:2", Message: chatMessage},
+			keepText: true,
+			requests: []string{"editMessageReplyMarkup", answer},
+		},
+		{
+			name:     "page counter",
+			query:    CallbackQuery{ID: "q", Data: "p:", Message: chatMessage},
+			requests: []string{answer},
+		},
+		{
+			name:     "invalid data",
+			query:    CallbackQuery{ID: "q", Data: "p:x", Message: chatMessage},
+			requests: []string{answer},
+			failures: 1,
+		},
+		{
+			name:     "no message",
+			query:    CallbackQuery{ID: "q", Data: "p:1"},
+			requests: []string{answer},
+			failures: 1,
+		},
+		{
+			name:     "message not modified",
+			query:    CallbackQuery{ID: "q", Data: "p:1", Message: chatMessage},
+			errors:   map[string]string{"editMessageText": notModified},
+			requests: []string{"editMessageText", answer},
+			failures: 1,
+		},
+		{
+			name:     "edit and answer failed",
+			query:    CallbackQuery{ID: "q", Data: "p:1", Message: chatMessage},
+			errors:   map[string]string{"editMessageText": notModified, "answerCallbackQuery": tooOld},
+			requests: []string{"editMessageText", answer},
+			failures: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := &testBotAPI{errors: test.errors}
+			p := &Paginator{Client: newTestClient(t, api.ServeHTTP), Prefix: "p", Items: testItems, KeepText: test.keepText}
+
+			err := p.HandleCallbackQuery(context.Background(), &test.query)
+
+			failures := 0
+			if joined, ok := err.(interface{ Unwrap() []error }); ok {
+				failures = len(joined.Unwrap())
+			} else if err != nil {
+				failures = 1
+			}
+
+			if failures != test.failures {
+				t.Errorf("HandleCallbackQuery() = %v, want %d errors", err, test.failures)
+			}
+
+			if test.errors["editMessageText"] != "" {
+				apiErr := &APIError{}
+				if !errors.As(err, &apiErr) || apiErr.ErrorCode != 400 {
+					t.Errorf("HandleCallbackQuery() = %v, want the error of the edit", err)
+				}
+			}
+
+			if len(api.requests) != len(test.requests) {
+				t.Fatalf("requests %v, want %v", api.requests, test.requests)
+			}
+
+			for i, request := range api.requests {
+				if method, _, _ := strings.Cut(request, " "); request != test.requests[i] && method != test.requests[i] {
+					t.Errorf("request %d = %s, want %s", i, request, test.requests[i])
+				}
+			}
+
+			if test.keepText {
+				edit := EditMessageReplyMarkup{}
+				if _, body, _ := strings.Cut(api.requests[0], " "); json.Unmarshal([]byte(body), &edit) != nil ||
+					edit.ChatID == nil || *edit.ChatID != "-5" || edit.MessageID == nil || *edit.MessageID != 7 {
+					t.Errorf("edit = %s, want the message of the chat", api.requests[0])
+				}
+			}
+		})
+	}
+}
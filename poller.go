@@ -0,0 +1,198 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultPollTimeout is the default timeout in seconds for long polling.
+	DefaultPollTimeout = 30
+	// DefaultMinBackoff is the default time to wait after a failed getUpdates request.
+	DefaultMinBackoff = time.Second
+	// DefaultMaxBackoff is the default maximum time to wait after consecutive failed getUpdates requests.
+	DefaultMaxBackoff = time.Minute
+)
+
+// Poller receives the updates of a bot by long polling getUpdates. The timeout of the http.Client of the Client must be longer
+// than the Timeout of the Poller. A Poller must not be run more than once at the same time.
+type Poller struct {
+	Client         *Client       // Client of the bot
+	Offset         int64         // Identifier of the next update to be delivered. It is advanced after every delivered update, so the poller can be run again without losing or redelivering updates.
+	Limit          *int64        // Optional. Limits the number of updates to be retrieved per request. Values between 1—100 are accepted. Defaults to 100.
+	Timeout        int64         // Optional. Timeout in seconds for long polling. Defaults to DefaultPollTimeout.
+	AllowedUpdates []string      // Optional. List the types of updates you want your bot to receive. See GetUpdates for details.
+	MinBackoff     time.Duration // Optional. Time to wait after a failed request, doubled after every further failure. Defaults to DefaultMinBackoff.
+	MaxBackoff     time.Duration // Optional. Maximum time to wait after consecutive failed requests. Defaults to DefaultMaxBackoff.
+	OnError        func(error)   // Optional. Called with the errors of the requests and of the handler.
+}
+
+// Run delivers the updates to the handler one by one until the context is canceled, and returns the error of the context.
+// An update is delivered when the handler returns, even with an error.
+func (p *Poller) Run(ctx context.Context, handler Handler) error {
+	return p.run(ctx, func(update *Update) bool {
+		err := handler.HandleUpdate(ctx, update)
+		if err != nil {
+			p.report(err)
+		}
+
+		return true
+	})
+}
+
+// Updates returns a channel delivering the updates until the context is canceled. An update is delivered when it is received
+// from the channel. The channel is closed when the poller stops.
+func (p *Poller) Updates(ctx context.Context) <-chan Update {
+	updates := make(chan Update)
+
+	go func() {
+		defer close(updates)
+
+		p.run(ctx, func(update *Update) bool {
+			select {
+			case updates <- *update:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return updates
+}
+
+// run requests the updates and delivers them until the context is canceled or an update is not delivered.
+func (p *Poller) run(ctx context.Context, deliver func(update *Update) bool) error {
+	confirmed := p.Offset
+	defer func() {
+		if p.Offset != confirmed {
+			p.confirm()
+		}
+	}()
+
+	backoff := time.Duration(0)
+
+	for ctx.Err() == nil {
+		request := p.request()
+
+		updates, err := Execute(ctx, p.Client, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			p.report(err)
+
+			backoff = p.backoff(backoff, err)
+			if !sleep(ctx, backoff) {
+				break
+			}
+
+			continue
+		}
+
+		backoff = 0
+
+		if request.Offset != nil {
+			confirmed = *request.Offset
+		}
+
+		for i := range updates {
+			if updates[i].UpdateID < p.Offset {
+				continue
+			}
+
+			if ctx.Err() != nil || !deliver(&updates[i]) {
+				return ctx.Err()
+			}
+
+			p.Offset = updates[i].UpdateID + 1
+		}
+	}
+
+	return ctx.Err()
+}
+
+// request returns the next getUpdates request.
+func (p *Poller) request() GetUpdates {
+	request := GetUpdates{
+		Limit:          p.Limit,
+		AllowedUpdates: p.AllowedUpdates,
+	}
+
+	if p.Offset != 0 {
+		offset := p.Offset
+		request.Offset = &offset
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultPollTimeout
+	}
+
+	request.Timeout = &timeout
+
+	return request
+}
+
+// confirm confirms the delivered updates to Telegram, so they are not received again after the poller stops.
+func (p *Poller) confirm() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	offset, limit, timeout := p.Offset, int64(1), int64(0)
+
+	err := p.Client.Do(ctx, GetUpdates{Offset: &offset, Limit: &limit, Timeout: &timeout}, nil)
+	if err != nil {
+		p.report(err)
+	}
+}
+
+// backoff returns the time to wait after a failed request. A flood control error is waited out as requested by Telegram.
+func (p *Poller) backoff(previous time.Duration, err error) time.Duration {
+	apiErr := &APIError{}
+	if errors.As(err, &apiErr) && apiErr.RetryAfter != nil {
+		return time.Duration(*apiErr.RetryAfter) * time.Second
+	}
+
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff == 0 {
+		minBackoff = DefaultMinBackoff
+	}
+
+	if maxBackoff == 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	backoff := previous * 2
+	if backoff < minBackoff {
+		backoff = minBackoff
+	}
+
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff
+}
+
+// report passes the error to OnError.
+func (p *Poller) report(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+	}
+}
+
+// sleep waits for the duration, or returns false if the context is canceled in the meantime.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,282 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeUpdates is a test server of getUpdates. It responds with the scripted responses first, then with the pending
+// updates from the offset of the request, dropping the updates before the offset as Telegram does.
+type fakeUpdates struct {
+	mu       sync.Mutex
+	script   []string     // Responses to the next requests
+	pending  []int64      // Identifiers of the updates which are not confirmed
+	requests []GetUpdates // Received requests
+}
+
+func (f *fakeUpdates) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := GetUpdates{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, request)
+
+	if len(f.script) > 0 {
+		io.WriteString(w, f.script[0])
+		f.script = f.script[1:]
+
+		return
+	}
+
+	if request.Offset != nil {
+		for len(f.pending) > 0 && f.pending[0] < *request.Offset {
+			f.pending = f.pending[1:]
+		}
+	}
+
+	limit := 100
+	if request.Limit != nil {
+		limit = int(*request.Limit)
+	}
+
+	updates := []Update{}
+	for _, id := range f.pending {
+		if len(updates) == limit {
+			break
+		}
+
+		updates = append(updates, Update{UpdateID: id, Message: &Message{MessageID: id, Chat: Chat{ID: 1, Type: "private"}}})
+	}
+
+	if len(updates) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	json.NewEncoder(w).Encode(Response[[]Update]{Ok: true, Result: updates})
+}
+
+// last returns the last request.
+func (f *fakeUpdates) last() GetUpdates {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[len(f.requests)-1]
+}
+
+// newTestPoller returns a Poller of the fake server.
+func newTestPoller(t *testing.T, fake *fakeUpdates) *Poller {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return &Poller{
+		Client:     NewClient(testToken, server.URL, server.Client()),
+		MinBackoff: time.Millisecond,
+		OnError:    func(err error) { t.Errorf("OnError(%v)", err) },
+	}
+}
+
+// runUntil runs the poller until the update with the identifier is delivered, and returns the delivered updates.
+func runUntil(t *testing.T, p *Poller, stop int64, handle func(update *Update) error) []int64 {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	delivered := []int64{}
+
+	err := p.Run(ctx, HandlerFunc(func(ctx context.Context, update *Update) error {
+		delivered = append(delivered, update.UpdateID)
+		if update.UpdateID == stop {
+			cancel()
+		}
+
+		if handle != nil {
+			return handle(update)
+		}
+
+		return nil
+	}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() = %v, want context.Canceled", err)
+	}
+
+	return delivered
+}
+
+// testConfirmed checks that the last request confirmed the updates before the offset.
+func testConfirmed(t *testing.T, fake *fakeUpdates, offset int64) {
+	t.Helper()
+
+	last := fake.last()
+	if last.Offset == nil || *last.Offset != offset || last.Limit == nil || *last.Limit != 1 || last.Timeout == nil || *last.Timeout != 0 {
+		t.Errorf("last request = %+v, want the confirmation of offset %d", last, offset)
+	}
+}
+
+func TestPollerRun(t *testing.T) {
+	fake := &fakeUpdates{pending: []int64{10, 11, 12, 13, 14}}
+	p := newTestPoller(t, fake)
+	p.Limit = new(int64)
+	*p.Limit = 2
+
+	delivered := runUntil(t, p, 14, nil)
+
+	if want := []int64{10, 11, 12, 13, 14}; !reflect.DeepEqual(delivered, want) {
+		t.Errorf("delivered %v, want %v", delivered, want)
+	}
+
+	if p.Offset != 15 {
+		t.Errorf("Offset = %d, want 15", p.Offset)
+	}
+
+	testConfirmed(t, fake, 15)
+
+	if len(fake.pending) != 0 {
+		t.Errorf("updates %v are not confirmed", fake.pending)
+	}
+
+	for _, request := range fake.requests[:len(fake.requests)-1] {
+		if request.Timeout == nil || *request.Timeout != DefaultPollTimeout || *request.Limit != 2 {
+			t.Errorf("request = %+v, want the timeout and the limit of the poller", request)
+		}
+	}
+}
+
+func TestPollerCancelMidBatch(t *testing.T) {
+	fake := &fakeUpdates{pending: []int64{1, 2, 3}}
+	p := newTestPoller(t, fake)
+
+	if delivered := runUntil(t, p, 2, nil); !reflect.DeepEqual(delivered, []int64{1, 2}) {
+		t.Errorf("delivered %v, want [1 2]", delivered)
+	}
+
+	if p.Offset != 3 {
+		t.Errorf("Offset = %d, want 3", p.Offset)
+	}
+
+	testConfirmed(t, fake, 3)
+
+	if delivered := runUntil(t, p, 3, nil); !reflect.DeepEqual(delivered, []int64{3}) {
+		t.Errorf("delivered %v after running again, want [3]", delivered)
+	}
+}
+
+func TestPollerHandlerError(t *testing.T) {
+	fake := &fakeUpdates{pending: []int64{1, 2}}
+	p := newTestPoller(t, fake)
+
+	reported := []error{}
+	p.OnError = func(err error) { reported = append(reported, err) }
+
+	failure := errors.New("handler failed")
+
+	if delivered := runUntil(t, p, 2, func(*Update) error { return failure }); len(delivered) != 2 {
+		t.Errorf("delivered %v, want [1 2]", delivered)
+	}
+
+	if len(reported) != 2 || reported[0] != failure {
+		t.Errorf("reported %v, want the errors of the handler", reported)
+	}
+
+	testConfirmed(t, fake, 3)
+}
+
+func TestPollerRequestErrors(t *testing.T) {
+	fake := &fakeUpdates{
+		script: []string{
+			`not json`,
+			`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 0","parameters":{"retry_after":0}}`,
+		},
+		pending: []int64{7},
+	}
+	p := newTestPoller(t, fake)
+
+	reported := []error{}
+	p.OnError = func(err error) { reported = append(reported, err) }
+
+	if delivered := runUntil(t, p, 7, nil); !reflect.DeepEqual(delivered, []int64{7}) {
+		t.Errorf("delivered %v, want [7]", delivered)
+	}
+
+	apiErr := &APIError{}
+	if len(reported) != 2 || !errors.As(reported[1], &apiErr) || apiErr.ErrorCode != 429 {
+		t.Errorf("reported %v, want the decoding error and the flood control error", reported)
+	}
+}
+
+func TestPollerUpdates(t *testing.T) {
+	fake := &fakeUpdates{pending: []int64{1, 2, 3}}
+	p := newTestPoller(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := p.Updates(ctx)
+
+	received := []int64{(<-updates).UpdateID}
+	cancel()
+
+	for update := range updates {
+		received = append(received, update.UpdateID)
+	}
+
+	if received[0] != 1 || p.Offset != received[len(received)-1]+1 {
+		t.Fatalf("received %v with Offset %d", received, p.Offset)
+	}
+
+	if p.Offset != 4 {
+		testConfirmed(t, fake, p.Offset)
+	}
+
+	rest := []int64{}
+	for id := p.Offset; id <= 3; id++ {
+		rest = append(rest, id)
+	}
+
+	if len(rest) > 0 {
+		if delivered := runUntil(t, p, 3, nil); !reflect.DeepEqual(delivered, rest) {
+			t.Errorf("delivered %v after receiving %v, want %v", delivered, received, rest)
+		}
+	}
+}
+
+func TestPollerBackoff(t *testing.T) {
+	retryAfter := int64(3)
+
+	tests := []struct {
+		previous time.Duration
+		err      error
+		want     time.Duration
+	}{
+		{0, errors.New("failed"), 10 * time.Millisecond},
+		{10 * time.Millisecond, errors.New("failed"), 20 * time.Millisecond},
+		{80 * time.Millisecond, errors.New("failed"), 100 * time.Millisecond},
+		{100 * time.Millisecond, errors.New("failed"), 100 * time.Millisecond},
+		{100 * time.Millisecond, &APIError{ErrorCode: 429, RetryAfter: &retryAfter}, 3 * time.Second},
+	}
+
+	p := &Poller{MinBackoff: 10 * time.Millisecond, MaxBackoff: 100 * time.Millisecond}
+
+	for _, test := range tests {
+		if got := p.backoff(test.previous, test.err); got != test.want {
+			t.Errorf("backoff(%v, %v) = %v, want %v", test.previous, test.err, got, test.want)
+		}
+	}
+
+	if got := (&Poller{}).backoff(0, errors.New("failed")); got != DefaultMinBackoff {
+		t.Errorf("default backoff = %v, want %v", got, DefaultMinBackoff)
+	}
+}
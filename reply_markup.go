@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ReplyMarkup represents reply markup: InlineKeyboardMarkup or ReplyKeyboardMarkup or ReplyKeyboardRemove or ForceReply, or
+// a pointer to them. It is implemented only by these types.
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+func (InlineKeyboardMarkup) replyMarkup() {}
+func (ReplyKeyboardMarkup) replyMarkup()  {}
+func (ReplyKeyboardRemove) replyMarkup()  {}
+func (ForceReply) replyMarkup()           {}
+
+// UnmarshalReplyMarkup decodes a JSON-serialized reply markup into a pointer to InlineKeyboardMarkup, ReplyKeyboardMarkup,
+// ReplyKeyboardRemove or ForceReply, telling them apart by their required field. Empty data or null is a nil ReplyMarkup.
+func UnmarshalReplyMarkup(data []byte) (ReplyMarkup, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	var markup ReplyMarkup
+
+	switch {
+	case fields["inline_keyboard"] != nil:
+		markup = &InlineKeyboardMarkup{}
+	case fields["keyboard"] != nil:
+		markup = &ReplyKeyboardMarkup{}
+	case fields["remove_keyboard"] != nil:
+		markup = &ReplyKeyboardRemove{}
+	case fields["force_reply"] != nil:
+		markup = &ForceReply{}
+	default:
+		return nil, fmt.Errorf("telegram: unknown reply markup %s", data)
+	}
+
+	if err := json.Unmarshal(data, markup); err != nil {
+		return nil, err
+	}
+
+	return markup, nil
+}
@@ -0,0 +1,81 @@
+package telegram
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestReplayReplyMarkup(t *testing.T) {
+	tests := []struct {
+		markup ReplyMarkup
+		want   ReplyMarkup
+	}{
+		{
+			InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "a", CallbackData: ptr("data")}}}},
+			&InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "a", CallbackData: ptr("data")}}}},
+		},
+		{
+			&ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "a"}}}, ResizeKeyboard: ptr(true)},
+			&ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "a"}}}, ResizeKeyboard: ptr(true)},
+		},
+		{ReplyKeyboardRemove{RemoveKeyboard: true}, &ReplyKeyboardRemove{RemoveKeyboard: true}},
+		{&ForceReply{ForceReply: true, Selective: ptr(true)}, &ForceReply{ForceReply: true, Selective: ptr(true)}},
+		{nil, nil},
+	}
+
+	for _, m := range methodStructs {
+		value := reflect.New(reflect.TypeOf(m)).Elem()
+
+		field := value.FieldByName("ReplyMarkup")
+		if !field.IsValid() || field.Type() != replyMarkupType {
+			continue
+		}
+
+		for _, test := range tests {
+			field.Set(reflect.Zero(replyMarkupType))
+			if test.markup != nil {
+				field.Set(reflect.ValueOf(test.markup))
+			}
+
+			data, err := json.Marshal(value.Interface())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			replayed := reflect.New(value.Type())
+			if err := json.Unmarshal(data, replayed.Interface()); err != nil {
+				t.Errorf("%s: decoding %s: %v", m.MethodName(), data, err)
+				continue
+			}
+
+			if got := replayed.Elem().FieldByName("ReplyMarkup").Interface(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%s: decoded the reply markup of %s as %#v, want %#v", m.MethodName(), data, got, test.want)
+			}
+		}
+	}
+}
+
+func TestUnmarshalReplyMarkup(t *testing.T) {
+	tests := []struct {
+		data string
+		want ReplyMarkup
+		err  bool
+	}{
+		{data: `{"inline_keyboard":[]}`, want: &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{}}},
+		{data: `{"keyboard":[[{"text":"a"}]]}`, want: &ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "a"}}}}},
+		{data: `{"remove_keyboard":true}`, want: &ReplyKeyboardRemove{RemoveKeyboard: true}},
+		{data: `{"force_reply":true}`, want: &ForceReply{ForceReply: true}},
+		{data: `null`},
+		{data: ``},
+		{data: `{"unknown":true}`, err: true},
+		{data: `[]`, err: true},
+	}
+
+	for _, test := range tests {
+		got, err := UnmarshalReplyMarkup([]byte(test.data))
+		if (err != nil) != test.err || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("UnmarshalReplyMarkup(%s) = %#v, %v, want %#v", test.data, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Response : The response of every Bot API request. If Ok is true, the request was successful and its result is in Result, otherwise the reason of the failure is in Description.
+type Response[T any] struct {
+	Ok          bool                `json:"ok"`                    // True, if the request was successful
+	Result      T                   `json:"result"`                // Optional. The result of the query, if the request was successful
+	Description *string             `json:"description,omitempty"` // Optional. Human-readable description of the result
+	ErrorCode   *int64              `json:"error_code,omitempty"`  // Optional. Error code of the unsuccessful request. Its contents are subject to change in the future.
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`  // Optional. Information about why the request was unsuccessful, which can help to automatically handle the error
+}
+
+// Err returns the *APIError of an unsuccessful response, or nil if the request was successful.
+func (r *Response[T]) Err() error {
+	if r.Ok {
+		return nil
+	}
+
+	apiErr := &APIError{}
+
+	if r.ErrorCode != nil {
+		apiErr.ErrorCode = *r.ErrorCode
+	}
+
+	if r.Description != nil {
+		apiErr.Description = *r.Description
+	}
+
+	if r.Parameters != nil {
+		apiErr.RetryAfter = r.Parameters.RetryAfter
+		apiErr.MigrateToChatID = r.Parameters.MigrateToChatID
+	}
+
+	return apiErr
+}
+
+// APIError represents an unsuccessful Bot API request. Use errors.As to get it from the error of a request.
+type APIError struct {
+	ErrorCode       int64  // Error code of the request, e.g. 400, 403 or 429
+	Description     string // Human-readable description of the error
+	RetryAfter      *int64 // Optional. In case of exceeding flood control, the number of seconds left to wait before the request can be repeated
+	MigrateToChatID *int64 // Optional. The group has been migrated to a supergroup with the specified identifier
+}
+
+// Error returns the error code and the description of the error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram: %d %s", e.ErrorCode, e.Description)
+}
+
+// EditedMessage is the result of the methods editing a message, which return the edited Message if it was sent by the bot,
+// and True if it is an inline message.
+type EditedMessage struct {
+	Message *Message // Optional. Edited message, nil for an inline message
+}
+
+// UnmarshalJSON decodes either the edited Message or True.
+func (m *EditedMessage) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("true")) {
+		m.Message = nil
+		return nil
+	}
+
+	message := new(Message)
+	if err := json.Unmarshal(data, message); err != nil {
+		return err
+	}
+
+	m.Message = message
+
+	return nil
+}
+
+// MarshalJSON encodes the edited Message, or True for an inline message.
+func (m EditedMessage) MarshalJSON() ([]byte, error) {
+	if m.Message == nil {
+		return []byte("true"), nil
+	}
+
+	return json.Marshal(m.Message)
+}
@@ -0,0 +1,106 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestResponseErr(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     *APIError // Nil if the request was successful
+	}{
+		{"ok", `{"ok":true,"result":true}`, nil},
+		{"error", `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`, &APIError{ErrorCode: 400, Description: "Bad Request: chat not found"}},
+		{"no description", `{"ok":false,"error_code":500}`, &APIError{ErrorCode: 500}},
+		{"retry after", `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`, &APIError{ErrorCode: 429, Description: "Too Many Requests: retry after 5", RetryAfter: ptr(int64(5))}},
+		{"migrate to chat id", `{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1001234567890}}`, &APIError{ErrorCode: 400, Description: "Bad Request: group chat was upgraded to a supergroup chat", MigrateToChatID: ptr(int64(-1001234567890))}},
+		{"empty parameters", `{"ok":false,"error_code":400,"description":"Bad Request","parameters":{}}`, &APIError{ErrorCode: 400, Description: "Bad Request"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := Response[bool]{}
+			if err := json.Unmarshal([]byte(test.response), &response); err != nil {
+				t.Fatal(err)
+			}
+
+			err := response.Err()
+			if test.want == nil {
+				if err != nil || !response.Result {
+					t.Errorf("Err() = %v, result %v, want nil and true", err, response.Result)
+				}
+
+				return
+			}
+
+			apiErr := &APIError{}
+			if !errors.As(err, &apiErr) || !reflect.DeepEqual(apiErr, test.want) {
+				t.Errorf("Err() = %#v, want %#v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorString(t *testing.T) {
+	tests := []struct {
+		err  *APIError
+		want string
+	}{
+		{&APIError{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"}, "telegram: 403 Forbidden: bot was blocked by the user"},
+		{&APIError{ErrorCode: 429, Description: "Too Many Requests: retry after 5", RetryAfter: ptr(int64(5))}, "telegram: 429 Too Many Requests: retry after 5"},
+		{&APIError{}, "telegram: 0 "},
+	}
+
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error() = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestEditedMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  string
+		message bool // Whether the result is the edited Message
+	}{
+		{"message", `{"ok":true,"result":{"message_id":7,"date":1,"chat":{"id":1,"type":"private"},"text":"edited"}}`, true},
+		{"inline message", `{"ok":true,"result":true}`, false},
+		{"spaced true", `{"ok":true,"result": true }`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := Response[EditedMessage]{}
+			if err := json.Unmarshal([]byte(test.result), &response); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := response.Result.Message != nil; got != test.message {
+				t.Fatalf("Message = %+v, want a message: %v", response.Result.Message, test.message)
+			}
+
+			if test.message && (response.Result.Message.MessageID != 7 || *response.Result.Message.Text != "edited") {
+				t.Errorf("Message = %+v, want the edited message", response.Result.Message)
+			}
+
+			data, err := json.Marshal(response.Result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded := EditedMessage{}
+			if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, response.Result) {
+				t.Errorf("json.Marshal() = %s, %v, want a round trip", data, err)
+			}
+		})
+	}
+
+	if err := json.Unmarshal([]byte(`false`), &EditedMessage{}); err == nil {
+		t.Error("json.Unmarshal() of false did not fail")
+	}
+}
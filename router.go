@@ -0,0 +1,238 @@
+package telegram
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// MessageFilter reports whether a message should be handled. A nil MessageFilter matches every message.
+type MessageFilter func(message *Message) bool
+
+// CallbackQueryFilter reports whether a callback query should be handled. A nil CallbackQueryFilter matches every callback query.
+type CallbackQueryFilter func(query *CallbackQuery) bool
+
+// MessageTypes matches the messages of the given types, e.g. TextType or NewChatMembersType.
+func MessageTypes(types ...string) MessageFilter {
+	return func(message *Message) bool {
+		return containsString(types, message.Type)
+	}
+}
+
+// ChatTypes matches the messages sent to chats of the given types: Private, Group, Supergroup or Channel.
+func ChatTypes(types ...string) MessageFilter {
+	return func(message *Message) bool {
+		return containsString(types, message.Chat.Type)
+	}
+}
+
+// TextMatches matches the text messages whose text matches the regular expression.
+func TextMatches(pattern *regexp.Regexp) MessageFilter {
+	return func(message *Message) bool {
+		return message.Text != nil && pattern.MatchString(*message.Text)
+	}
+}
+
+// FromUsers matches the messages sent by the given users.
+func FromUsers(userIDs ...int64) MessageFilter {
+	return func(message *Message) bool {
+		if message.From == nil {
+			return false
+		}
+
+		for _, userID := range userIDs {
+			if message.From.ID == userID {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// And matches the messages matched by both filters.
+func (f MessageFilter) And(other MessageFilter) MessageFilter {
+	return func(message *Message) bool {
+		return f.Match(message) && other.Match(message)
+	}
+}
+
+// Or matches the messages matched by either filter.
+func (f MessageFilter) Or(other MessageFilter) MessageFilter {
+	return func(message *Message) bool {
+		return f.Match(message) || other.Match(message)
+	}
+}
+
+// Not matches the messages not matched by the filter.
+func (f MessageFilter) Not() MessageFilter {
+	return func(message *Message) bool {
+		return !f.Match(message)
+	}
+}
+
+// Match reports whether the filter matches the message.
+func (f MessageFilter) Match(message *Message) bool {
+	return f == nil || f(message)
+}
+
+// CallbackDataPrefix matches the callback queries whose data starts with the prefix.
+func CallbackDataPrefix(prefix string) CallbackQueryFilter {
+	return func(query *CallbackQuery) bool {
+		return strings.HasPrefix(query.Data, prefix)
+	}
+}
+
+// Match reports whether the filter matches the callback query.
+func (f CallbackQueryFilter) Match(query *CallbackQuery) bool {
+	return f == nil || f(query)
+}
+
+// route is a registered handler of a Router.
+type route struct {
+	kind   UpdateKind
+	match  func(update *Update) bool
+	handle func(ctx context.Context, update *Update) error
+}
+
+// Router is a Handler passing every update to the first registered handler matching it, in the order of registration.
+// The handlers must be registered before the router handles updates.
+type Router struct {
+	routes   []route
+	fallback Handler
+}
+
+// OnMessage registers a handler of the new incoming messages matched by the filter.
+func (r *Router) OnMessage(filter MessageFilter, handler func(ctx context.Context, message *Message) error) {
+	r.onMessage(MessageUpdate, filter, handler)
+}
+
+// OnEditedMessage registers a handler of the edited messages matched by the filter.
+func (r *Router) OnEditedMessage(filter MessageFilter, handler func(ctx context.Context, message *Message) error) {
+	r.onMessage(EditedMessageUpdate, filter, handler)
+}
+
+// OnChannelPost registers a handler of the new incoming channel posts matched by the filter.
+func (r *Router) OnChannelPost(filter MessageFilter, handler func(ctx context.Context, message *Message) error) {
+	r.onMessage(ChannelPostUpdate, filter, handler)
+}
+
+// OnEditedChannelPost registers a handler of the edited channel posts matched by the filter.
+func (r *Router) OnEditedChannelPost(filter MessageFilter, handler func(ctx context.Context, message *Message) error) {
+	r.onMessage(EditedChannelPostUpdate, filter, handler)
+}
+
+// OnCallbackQuery registers a handler of the callback queries matched by the filter.
+func (r *Router) OnCallbackQuery(filter CallbackQueryFilter, handler func(ctx context.Context, query *CallbackQuery) error) {
+	r.routes = append(r.routes, route{
+		kind: CallbackQueryUpdate,
+		match: func(update *Update) bool {
+			return filter.Match(update.CallbackQuery)
+		},
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, update.CallbackQuery)
+		},
+	})
+}
+
+// OnInlineQuery registers a handler of the inline queries.
+func (r *Router) OnInlineQuery(handler func(ctx context.Context, query *Query) error) {
+	r.routes = append(r.routes, route{
+		kind: InlineQueryUpdate,
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, update.InlineQuery)
+		},
+	})
+}
+
+// OnChosenInlineResult registers a handler of the chosen inline results.
+func (r *Router) OnChosenInlineResult(handler func(ctx context.Context, result *ChosenInlineResult) error) {
+	r.routes = append(r.routes, route{
+		kind: ChosenInlineResultUpdate,
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, update.ChosenInlineResult)
+		},
+	})
+}
+
+// OnShippingQuery registers a handler of the shipping queries.
+func (r *Router) OnShippingQuery(handler func(ctx context.Context, query *ShippingQuery) error) {
+	r.routes = append(r.routes, route{
+		kind: ShippingQueryUpdate,
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, update.ShippingQuery)
+		},
+	})
+}
+
+// OnPreCheckoutQuery registers a handler of the pre-checkout queries.
+func (r *Router) OnPreCheckoutQuery(handler func(ctx context.Context, query *PreCheckoutQuery) error) {
+	r.routes = append(r.routes, route{
+		kind: PreCheckoutQueryUpdate,
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, update.PreCheckoutQuery)
+		},
+	})
+}
+
+// Fallback registers the handler of the updates not matched by any other handler.
+func (r *Router) Fallback(handler Handler) {
+	r.fallback = handler
+}
+
+// HandleUpdate passes the update to the first matching handler, or to the fallback handler if there is none.
+func (r *Router) HandleUpdate(ctx context.Context, update *Update) error {
+	kind := update.Kind()
+
+	for _, route := range r.routes {
+		if route.kind != kind || (route.match != nil && !route.match(update)) {
+			continue
+		}
+
+		return route.handle(ctx, update)
+	}
+
+	if r.fallback != nil {
+		return r.fallback.HandleUpdate(ctx, update)
+	}
+
+	return nil
+}
+
+// onMessage registers a handler of the messages of the given kind of update.
+func (r *Router) onMessage(kind UpdateKind, filter MessageFilter, handler func(ctx context.Context, message *Message) error) {
+	r.routes = append(r.routes, route{
+		kind: kind,
+		match: func(update *Update) bool {
+			return filter.Match(updateMessage(update))
+		},
+		handle: func(ctx context.Context, update *Update) error {
+			return handler(ctx, updateMessage(update))
+		},
+	})
+}
+
+// updateMessage returns the message, edited message, channel post or edited channel post of the update.
+func updateMessage(update *Update) *Message {
+	switch {
+	case update.Message != nil:
+		return update.Message
+	case update.EditedMessage != nil:
+		return update.EditedMessage
+	case update.ChannelPost != nil:
+		return update.ChannelPost
+	}
+
+	return update.EditedChannelPost
+}
+
+// containsString reports whether the value is one of the values.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
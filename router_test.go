@@ -0,0 +1,143 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestMessageFilters(t *testing.T) {
+	text := &Message{Type: TextType, Text: ptr("hi there"), Chat: Chat{Type: Private}, From: &User{ID: 1}}
+	photo := &Message{Type: PhotoType, Chat: Chat{Type: Group}, From: &User{ID: 2}}
+	post := &Message{Type: TextType, Text: ptr("news"), Chat: Chat{Type: Channel}}
+
+	hi := TextMatches(regexp.MustCompile(`^hi\b`))
+
+	tests := []struct {
+		name   string
+		filter MessageFilter
+		want   []bool // Matches of text, photo and post
+	}{
+		{"nil", nil, []bool{true, true, true}},
+		{"message types", MessageTypes(PhotoType, VideoType), []bool{false, true, false}},
+		{"chat types", ChatTypes(Private, Group), []bool{true, true, false}},
+		{"text matches", hi, []bool{true, false, false}},
+		{"from users", FromUsers(2, 3), []bool{false, true, false}},
+		{"and", MessageTypes(TextType).And(ChatTypes(Channel)), []bool{false, false, true}},
+		{"or", hi.Or(MessageTypes(PhotoType)), []bool{true, true, false}},
+		{"not", ChatTypes(Channel).Not(), []bool{true, true, false}},
+		{"and nil", MessageFilter(nil).And(FromUsers(1)), []bool{true, false, false}},
+		{"or nil", FromUsers(1).Or(nil), []bool{true, true, true}},
+		{"not nil", MessageFilter(nil).Not(), []bool{false, false, false}},
+		{"nested", MessageTypes(TextType).And(hi.Not().Or(FromUsers(1))), []bool{true, false, true}},
+	}
+
+	for _, test := range tests {
+		for i, message := range []*Message{text, photo, post} {
+			if got := test.filter.Match(message); got != test.want[i] {
+				t.Errorf("%s: Match(%s in %s) = %v, want %v", test.name, message.Type, message.Chat.Type, got, test.want[i])
+			}
+		}
+	}
+}
+
+func TestCallbackQueryFilters(t *testing.T) {
+	tests := []struct {
+		filter CallbackQueryFilter
+		data   string
+		want   bool
+	}{
+		{nil, "anything", true},
+		{CallbackDataPrefix("page:"), "page:2", true},
+		{CallbackDataPrefix("page:"), "page", false},
+		{CallbackDataPrefix(""), "", true},
+	}
+
+	for _, test := range tests {
+		if got := test.filter.Match(&CallbackQuery{Data: test.data}); got != test.want {
+			t.Errorf("Match(%q) = %v, want %v", test.data, got, test.want)
+		}
+	}
+}
+
+func TestRouter(t *testing.T) {
+	handled := ""
+	record := func(name string) func(ctx context.Context, message *Message) error {
+		return func(ctx context.Context, message *Message) error {
+			handled = name
+			return nil
+		}
+	}
+
+	failure := errors.New("handler failed")
+
+	r := &Router{}
+	r.OnMessage(MessageTypes(TextType).And(ChatTypes(Private)), record("private text"))
+	r.OnMessage(MessageTypes(TextType), record("text"))
+	r.OnMessage(nil, record("message"))
+	r.OnEditedMessage(nil, record("edited message"))
+	r.OnChannelPost(nil, func(ctx context.Context, message *Message) error { return failure })
+	r.OnCallbackQuery(CallbackDataPrefix("page:"), func(ctx context.Context, query *CallbackQuery) error {
+		handled = "page " + query.Data
+		return nil
+	})
+	r.OnInlineQuery(func(ctx context.Context, query *Query) error {
+		handled = "inline query " + query.Query
+		return nil
+	})
+	r.Fallback(HandlerFunc(func(ctx context.Context, update *Update) error {
+		handled = "fallback"
+		return nil
+	}))
+
+	tests := []struct {
+		name   string
+		update string
+		want   string
+		err    error
+	}{
+		{"first match", `{"update_id":1,"message":{"message_id":1,"date":1,"chat":{"id":1,"type":"private"},"text":"hi"}}`, "private text", nil},
+		{"second match", `{"update_id":1,"message":{"message_id":1,"date":1,"chat":{"id":1,"type":"group"},"text":"hi"}}`, "text", nil},
+		{"nil filter", `{"update_id":1,"message":{"message_id":1,"date":1,"chat":{"id":1,"type":"group"},"sticker":{"file_id":"s","width":1,"height":1}}}`, "message", nil},
+		{"kind", `{"update_id":1,"edited_message":{"message_id":1,"date":1,"chat":{"id":1,"type":"private"},"text":"hi"}}`, "edited message", nil},
+		{"handler error", `{"update_id":1,"channel_post":{"message_id":1,"date":1,"chat":{"id":1,"type":"channel"},"text":"hi"}}`, "", failure},
+		{"callback query", `{"update_id":1,"callback_query":{"id":"q","from":{"id":1,"is_bot":false,"first_name":"a"},"chat_instance":"c","data":"page:2"}}`, "page page:2", nil},
+		{"unmatched callback query", `{"update_id":1,"callback_query":{"id":"q","from":{"id":1,"is_bot":false,"first_name":"a"},"chat_instance":"c","data":"other"}}`, "fallback", nil},
+		{"inline query", `{"update_id":1,"inline_query":{"id":"q","from":{"id":1,"is_bot":false,"first_name":"a"},"query":"cats","offset":""}}`, "inline query cats", nil},
+		{"unrouted kind", `{"update_id":1,"edited_channel_post":{"message_id":1,"date":1,"chat":{"id":1,"type":"channel"},"text":"hi"}}`, "fallback", nil},
+		{"unknown kind", `{"update_id":1}`, "fallback", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			update, _, err := DecodeUpdate([]byte(test.update))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			handled = ""
+
+			if err := r.HandleUpdate(context.Background(), update); err != test.err {
+				t.Errorf("HandleUpdate() = %v, want %v", err, test.err)
+			}
+
+			if handled != test.want {
+				t.Errorf("handled by %q, want %q", handled, test.want)
+			}
+		})
+	}
+}
+
+func TestRouterWithoutFallback(t *testing.T) {
+	r := &Router{}
+	r.OnMessage(MessageTypes(PhotoType), func(ctx context.Context, message *Message) error {
+		t.Error("a text was handled as a photo")
+		return nil
+	})
+
+	update := &Update{Message: &Message{Type: TextType, Text: ptr("hi")}}
+	if err := r.HandleUpdate(context.Background(), update); err != nil {
+		t.Errorf("HandleUpdate() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,322 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// ProblemKind is a kind of difference between a Go struct and the schema.
+type ProblemKind string
+
+const (
+	// Unregistered is a type or method of the schema without a Go type.
+	Unregistered ProblemKind = "unregistered"
+	// Unknown is a field of a Go struct which is not in the schema, e.g. a misspelled json tag.
+	Unknown ProblemKind = "unknown field"
+	// Missing is a field of the schema which is not in the Go struct.
+	Missing ProblemKind = "missing field"
+	// Mistyped is a field whose Go type can not hold the types of the schema.
+	Mistyped ProblemKind = "mistyped field"
+	// Misnamed is a field whose Go name does not match its json name.
+	Misnamed ProblemKind = "misnamed field"
+	// Untagged is an exported field without a json tag.
+	Untagged ProblemKind = "untagged field"
+	// AlwaysSent is an optional field which is encoded even if it is not set.
+	AlwaysSent ProblemKind = "optional field always sent"
+	// NotSubtype is a subtype of an abstract type whose Go type does not implement the Go type of the abstract type.
+	NotSubtype ProblemKind = "not a subtype"
+	// WrongResult is a method whose Go result type can not hold the types it returns.
+	WrongResult ProblemKind = "wrong result"
+)
+
+// Problem is a difference between a Go struct and the schema.
+type Problem struct {
+	Object string      // Name of the type or method, e.g. sendMessage
+	Field  string      // Name of the field, empty for a problem of the whole object
+	Kind   ProblemKind // Kind of the problem
+	Detail string      // Description of the problem
+}
+
+// String returns the problem as e.g. "sendVideo.support_streaming: unknown field: SendVideo.SupportStreaming".
+func (p Problem) String() string {
+	name := p.Object
+	if p.Field != "" {
+		name += "." + p.Field
+	}
+
+	return fmt.Sprintf("%s: %s: %s", name, p.Kind, p.Detail)
+}
+
+// Registry maps the names of the schema to the Go types implementing them.
+type Registry struct {
+	Types   map[string]reflect.Type // Go types of the types, by name. A union of types can be registered as e.g. "Message or True".
+	Methods map[string]reflect.Type // Go request structs of the methods, by name, which must have a NewResult() *R method
+}
+
+// Check reflects over the Go types of the registry and reports their unknown, missing, mistyped, misnamed and untagged
+// fields, the optional fields which are always sent, and the result types of the methods. The fields of the embedded
+// structs are checked as the fields of the struct embedding them.
+func (s *Schema) Check(registry Registry) []Problem {
+	c := checker{registry: registry}
+
+	for _, t := range s.Types {
+		goType, ok := registry.Types[t.Name]
+		if !ok {
+			c.report(t.Name, "", Unregistered, "no Go type")
+			continue
+		}
+
+		if len(t.Subtypes) > 0 {
+			c.checkSubtypes(t, goType)
+			continue
+		}
+
+		c.checkFields(t.Name, t.Fields, goType)
+	}
+
+	for _, m := range s.Methods {
+		goType, ok := registry.Methods[m.Name]
+		if !ok {
+			c.report(m.Name, "", Unregistered, "no Go request struct")
+			continue
+		}
+
+		c.checkFields(m.Name, m.Fields, goType)
+		c.checkResult(m, goType)
+	}
+
+	return c.problems
+}
+
+// checker collects the problems of a check.
+type checker struct {
+	registry Registry
+	problems []Problem
+}
+
+// report adds a problem.
+func (c *checker) report(object, field string, kind ProblemKind, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{Object: object, Field: field, Kind: kind, Detail: fmt.Sprintf(format, args...)})
+}
+
+// checkFields compares the fields of the Go struct with the fields of the schema.
+func (c *checker) checkFields(object string, fields []*Field, goType reflect.Type) {
+	if goType.Kind() != reflect.Struct {
+		c.report(object, "", Mistyped, "Go type %s is not a struct", goType)
+		return
+	}
+
+	seen := map[string]bool{}
+
+	for _, sf := range structFields(goType) {
+		name, options, tagged := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+
+		goName := goType.Name() + "." + sf.Name
+
+		if !tagged {
+			c.report(object, "", Untagged, "%s has no json tag", goName)
+			continue
+		}
+
+		field := findField(fields, name)
+		if field == nil {
+			c.report(object, name, Unknown, "%s is not in the schema", goName)
+			continue
+		}
+
+		seen[name] = true
+
+		if !c.compatible(sf.Type, field.Types) {
+			c.report(object, name, Mistyped, "%s is %s, not %s", goName, sf.Type, field.TypeString())
+		}
+
+		if !strings.HasSuffix(goFieldName(name), sf.Name) {
+			c.report(object, name, Misnamed, "%s should be named %s", goName, goFieldName(name))
+		}
+
+		if !field.Required && !omitted(options) {
+			c.report(object, name, AlwaysSent, "%s has no omitempty option", goName)
+		}
+	}
+
+	for _, field := range fields {
+		if !seen[field.Name] {
+			c.report(object, field.Name, Missing, "%s has no field for it", goType.Name())
+		}
+	}
+}
+
+// checkSubtypes checks that the Go type of an abstract type is an interface implemented by the Go types of its subtypes.
+func (c *checker) checkSubtypes(t *Type, goType reflect.Type) {
+	if goType.Kind() != reflect.Interface {
+		c.report(t.Name, "", Mistyped, "Go type %s of an abstract type is not an interface", goType)
+		return
+	}
+
+	for _, name := range t.Subtypes {
+		subtype, ok := c.registry.Types[name]
+		if !ok {
+			continue
+		}
+
+		if !subtype.Implements(goType) && !reflect.PointerTo(subtype).Implements(goType) {
+			c.report(t.Name, "", NotSubtype, "%s does not implement %s", subtype, goType)
+		}
+	}
+}
+
+// checkResult checks that the result type of the request struct can hold the types the method returns.
+func (c *checker) checkResult(m *Method, goType reflect.Type) {
+	newResult, ok := goType.MethodByName("NewResult")
+	if !ok || newResult.Type.NumOut() != 1 || newResult.Type.Out(0).Kind() != reflect.Pointer {
+		c.report(m.Name, "", WrongResult, "%s has no NewResult method returning a pointer", goType)
+		return
+	}
+
+	result := newResult.Type.Out(0).Elem()
+
+	if !c.compatible(result, m.Returns) {
+		c.report(m.Name, "", WrongResult, "%s returns %s, not %s", goType, result, strings.Join(m.Returns, " or "))
+	}
+}
+
+// compatible reports whether the Go type can hold the types of the schema: one of them, or all of them if it is a
+// registered union or an interface implemented by all of them, e.g. InputMedia for the elements of "Array of
+// InputMediaPhoto or Array of InputMediaVideo".
+func (c *checker) compatible(goType reflect.Type, types []string) bool {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+
+	if goType == reflect.TypeOf(json.RawMessage{}) {
+		return true
+	}
+
+	if union, ok := c.registry.Types[strings.Join(types, " or ")]; ok && union == goType {
+		return true
+	}
+
+	if goType.Kind() == reflect.Interface {
+		all := true
+
+		for _, name := range types {
+			t, ok := c.registry.Types[name]
+			all = all && ok && (t.Implements(goType) || reflect.PointerTo(t).Implements(goType))
+		}
+
+		if all {
+			return true
+		}
+	}
+
+	for _, name := range types {
+		if c.compatibleWith(goType, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compatibleWith reports whether the Go type, which is not a pointer, can hold the type of the schema.
+func (c *checker) compatibleWith(goType reflect.Type, name string) bool {
+	if elem, ok := strings.CutPrefix(name, "Array of "); ok {
+		return goType.Kind() == reflect.Slice && c.compatible(goType.Elem(), []string{elem})
+	}
+
+	switch name {
+	case "Integer":
+		switch goType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		}
+
+		return false
+	case "Float":
+		return goType.Kind() == reflect.Float32 || goType.Kind() == reflect.Float64
+	case "String":
+		return goType.Kind() == reflect.String
+	case "Boolean", "True":
+		return goType.Kind() == reflect.Bool
+	}
+
+	registered, ok := c.registry.Types[name]
+
+	return ok && registered == goType
+}
+
+// structFields returns the fields of the struct, with the fields of its embedded structs in place of them.
+func structFields(t reflect.Type) []reflect.StructField {
+	fields := []reflect.StructField{}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		if sf.Anonymous && sf.Tag.Get("json") == "" && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, structFields(sf.Type)...)
+			continue
+		}
+
+		if sf.IsExported() {
+			fields = append(fields, sf)
+		}
+	}
+
+	return fields
+}
+
+// jsonName returns the json name and the options of the field, and whether it has a json tag.
+func jsonName(sf reflect.StructField) (string, string, bool) {
+	tag, ok := sf.Tag.Lookup("json")
+	if !ok {
+		return "", "", false
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+
+	return name, options, true
+}
+
+// omitted reports whether an unset field is left out of the encoded JSON. A nil pointer without omitempty is sent as null.
+func omitted(options string) bool {
+	for _, option := range strings.Split(options, ",") {
+		if option == "omitempty" || option == "omitzero" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// goFieldName returns the Go name of a json name, e.g. ChatID for chat_id. The Go name of a field may leave out the
+// beginning of it, e.g. URL for gif_url in ResultGif.
+func goFieldName(name string) string {
+	var b strings.Builder
+
+	for _, word := range strings.Split(name, "_") {
+		if initialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+
+		for i, r := range word {
+			if i == 0 {
+				r = unicode.ToUpper(r)
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]bool{"id": true, "url": true}
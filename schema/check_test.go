@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+// checkSchema is a schema of a group's permissions and a method to set them.
+var checkSchema = &Schema{
+	Types: []*Type{{
+		Name: "ChatPermissions",
+		Fields: []*Field{
+			{Name: "title", Types: []string{"String"}, Required: true},
+			{Name: "can_change_info", Types: []string{"Boolean"}},
+			{Name: "can_pin_messages", Types: []string{"Boolean"}},
+		},
+	}},
+	Methods: []*Method{{
+		Name:    "setChatPermissions",
+		Returns: []string{"True"},
+		Fields: []*Field{
+			{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true},
+			{Name: "permissions", Types: []string{"ChatPermissions"}, Required: true},
+		},
+	}},
+}
+
+type chatPermissions struct {
+	Title          string `json:"title"`
+	CanChangeInfo  *bool  `json:"can_change_info,omitempty"`
+	CanPinMessages *bool  `json:"can_pin_messages,omitempty"`
+}
+
+type setChatPermissions struct {
+	ChatID      string          `json:"chat_id"`
+	Permissions chatPermissions `json:"permissions"`
+}
+
+func (setChatPermissions) NewResult() *bool { return new(bool) }
+
+// wrongChatPermissions has a misspelled tag, a field of the wrong type and a missing field.
+type wrongChatPermissions struct {
+	Title         int   `json:"title"`
+	CanChangeInfo *bool `json:"can_chane_info,omitempty"`
+}
+
+func TestCheck(t *testing.T) {
+	methods := map[string]reflect.Type{"setChatPermissions": reflect.TypeOf(setChatPermissions{})}
+
+	problems := checkSchema.Check(Registry{
+		Types:   map[string]reflect.Type{"ChatPermissions": reflect.TypeOf(chatPermissions{})},
+		Methods: methods,
+	})
+	if len(problems) != 0 {
+		t.Errorf("Check() of the right structs = %v, want no problems", problems)
+	}
+
+	types := &Schema{Types: checkSchema.Types}
+	problems = types.Check(Registry{Types: map[string]reflect.Type{"ChatPermissions": reflect.TypeOf(wrongChatPermissions{})}})
+
+	got := map[string]ProblemKind{}
+	for _, p := range problems {
+		got[p.Object+"."+p.Field] = p.Kind
+	}
+
+	want := map[string]ProblemKind{
+		"ChatPermissions.title":            Mistyped,
+		"ChatPermissions.can_chane_info":   Unknown,
+		"ChatPermissions.can_change_info":  Missing,
+		"ChatPermissions.can_pin_messages": Missing,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %v, want %v", problems, want)
+	}
+
+	wantStrings := map[string]bool{
+		"ChatPermissions.title: mistyped field: wrongChatPermissions.Title is int, not String":                   true,
+		"ChatPermissions.can_chane_info: unknown field: wrongChatPermissions.CanChangeInfo is not in the schema": true,
+		"ChatPermissions.can_pin_messages: missing field: wrongChatPermissions has no field for it":              true,
+	}
+
+	for _, p := range problems {
+		delete(wantStrings, p.String())
+	}
+
+	if len(wantStrings) != 0 {
+		t.Errorf("Check() = %v, want the problems %v", problems, wantStrings)
+	}
+}
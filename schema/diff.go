@@ -0,0 +1,116 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChangeKind is a kind of difference between two schemas.
+type ChangeKind string
+
+const (
+	// Added is a type, method or field of the next schema which is not in the current one.
+	Added ChangeKind = "added"
+	// Removed is a type, method or field of the current schema which is not in the next one.
+	Removed ChangeKind = "removed"
+	// Changed is a field whose types or optionality, or a method whose result types, are different in the next schema.
+	Changed ChangeKind = "changed"
+)
+
+// Change is a difference between two schemas.
+type Change struct {
+	Object string     // Name of the type or method, e.g. sendMessage
+	Field  string     // Name of the field, empty for a change of the whole object
+	Kind   ChangeKind // Kind of the change
+	Detail string     // Description of the change, e.g. "Integer, required -> Integer or String, required"
+}
+
+// String returns the change as e.g. "added sendVideo.supports_streaming: Boolean, optional".
+func (c Change) String() string {
+	name := c.Object
+	if c.Field != "" {
+		name += "." + c.Field
+	}
+
+	return fmt.Sprintf("%-7s %s: %s", c.Kind, name, c.Detail)
+}
+
+// Diff returns the types, methods and fields added, removed and changed from the current schema to the next one.
+func Diff(current, next *Schema) []Change {
+	changes := []Change{}
+
+	for _, t := range current.Types {
+		if next.Type(t.Name) == nil {
+			changes = append(changes, Change{Object: t.Name, Kind: Removed, Detail: "type"})
+		}
+	}
+
+	for _, t := range next.Types {
+		old := current.Type(t.Name)
+		if old == nil {
+			changes = append(changes, Change{Object: t.Name, Kind: Added, Detail: fmt.Sprintf("type with %d fields", len(t.Fields))})
+			continue
+		}
+
+		changes = append(changes, diffFields(t.Name, old.Fields, t.Fields)...)
+
+		if strings.Join(old.Subtypes, ", ") != strings.Join(t.Subtypes, ", ") {
+			changes = append(changes, Change{Object: t.Name, Kind: Changed, Detail: fmt.Sprintf("subtypes %s -> %s", strings.Join(old.Subtypes, ", "), strings.Join(t.Subtypes, ", "))})
+		}
+	}
+
+	for _, m := range current.Methods {
+		if next.Method(m.Name) == nil {
+			changes = append(changes, Change{Object: m.Name, Kind: Removed, Detail: "method"})
+		}
+	}
+
+	for _, m := range next.Methods {
+		old := current.Method(m.Name)
+		if old == nil {
+			changes = append(changes, Change{Object: m.Name, Kind: Added, Detail: fmt.Sprintf("method with %d parameters", len(m.Fields))})
+			continue
+		}
+
+		changes = append(changes, diffFields(m.Name, old.Fields, m.Fields)...)
+
+		if returns, oldReturns := strings.Join(m.Returns, " or "), strings.Join(old.Returns, " or "); returns != oldReturns {
+			changes = append(changes, Change{Object: m.Name, Kind: Changed, Detail: fmt.Sprintf("returns %s -> %s", oldReturns, returns)})
+		}
+	}
+
+	return changes
+}
+
+// diffFields returns the fields added, removed and changed from the current fields of the object to the next ones.
+func diffFields(object string, current, next []*Field) []Change {
+	changes := []Change{}
+
+	for _, f := range current {
+		if findField(next, f.Name) == nil {
+			changes = append(changes, Change{Object: object, Field: f.Name, Kind: Removed, Detail: f.summary()})
+		}
+	}
+
+	for _, f := range next {
+		old := findField(current, f.Name)
+
+		switch {
+		case old == nil:
+			changes = append(changes, Change{Object: object, Field: f.Name, Kind: Added, Detail: f.summary()})
+		case old.summary() != f.summary():
+			changes = append(changes, Change{Object: object, Field: f.Name, Kind: Changed, Detail: old.summary() + " -> " + f.summary()})
+		}
+	}
+
+	return changes
+}
+
+// summary returns the types and the optionality of the field, e.g. "Integer or String, required".
+func (f *Field) summary() string {
+	if f.Required {
+		return f.TypeString() + ", required"
+	}
+
+	return f.TypeString() + ", optional"
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	current := &Schema{
+		Types: []*Type{
+			{Name: "User", Fields: []*Field{
+				{Name: "id", Types: []string{"Integer"}, Required: true},
+				{Name: "first_name", Types: []string{"String"}, Required: true},
+				{Name: "username", Types: []string{"String"}},
+			}},
+			{Name: "Chat", Fields: []*Field{{Name: "id", Types: []string{"Integer"}, Required: true}}},
+			{Name: "InputMedia", Subtypes: []string{"InputMediaPhoto", "InputMediaVideo"}},
+		},
+		Methods: []*Method{
+			{Name: "getMe", Returns: []string{"User"}},
+			{Name: "sendMessage", Returns: []string{"Message"}, Fields: []*Field{
+				{Name: "chat_id", Types: []string{"Integer"}, Required: true},
+				{Name: "text", Types: []string{"String"}, Required: true},
+			}},
+			{Name: "editMessageText", Returns: []string{"Message"}},
+			{Name: "deleteChatPhoto", Returns: []string{"True"}},
+		},
+	}
+
+	next := &Schema{
+		Types: []*Type{
+			{Name: "User", Fields: []*Field{
+				{Name: "id", Types: []string{"Integer"}, Required: true, Description: "A new description"},
+				{Name: "is_bot", Types: []string{"Boolean"}, Required: true},
+				{Name: "username", Types: []string{"String"}, Required: true},
+			}},
+			{Name: "InputMedia", Subtypes: []string{"InputMediaAnimation", "InputMediaPhoto", "InputMediaVideo"}},
+			{Name: "Animation", Fields: []*Field{
+				{Name: "file_id", Types: []string{"String"}, Required: true},
+				{Name: "thumb", Types: []string{"PhotoSize"}},
+				{Name: "file_name", Types: []string{"String"}},
+			}},
+		},
+		Methods: []*Method{
+			{Name: "getMe", Returns: []string{"User"}},
+			{Name: "sendMessage", Returns: []string{"Message"}, Fields: []*Field{
+				{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true},
+				{Name: "text", Types: []string{"String"}, Required: true},
+			}},
+			{Name: "editMessageText", Returns: []string{"Message", "True"}},
+			{Name: "sendAnimation", Returns: []string{"Message"}, Fields: []*Field{
+				{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true},
+				{Name: "animation", Types: []string{"InputFile", "String"}, Required: true},
+			}},
+		},
+	}
+
+	want := []string{
+		"removed Chat: type",
+		"removed User.first_name: String, required",
+		"added   User.is_bot: Boolean, required",
+		"changed User.username: String, optional -> String, required",
+		"changed InputMedia: subtypes InputMediaPhoto, InputMediaVideo -> InputMediaAnimation, InputMediaPhoto, InputMediaVideo",
+		"added   Animation: type with 3 fields",
+		"removed deleteChatPhoto: method",
+		"changed sendMessage.chat_id: Integer, required -> Integer or String, required",
+		"changed editMessageText: returns Message -> Message or True",
+		"added   sendAnimation: method with 2 parameters",
+	}
+
+	got := []string{}
+	for _, change := range Diff(current, next) {
+		got = append(got, change.String())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() =\n%q\nwant\n%q", got, want)
+	}
+
+	if changes := Diff(next, next); len(changes) != 0 {
+		t.Errorf("Diff() of the same schema = %v, want no changes", changes)
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	tests := []struct {
+		change Change
+		want   string
+	}{
+		{Change{Object: "sendVideo", Field: "supports_streaming", Kind: Added, Detail: "Boolean, optional"}, "added   sendVideo.supports_streaming: Boolean, optional"},
+		{Change{Object: "Chat", Kind: Removed, Detail: "type"}, "removed Chat: type"},
+		{Change{Object: "getMe", Kind: Changed, Detail: "returns User -> User or True"}, "changed getMe: returns User -> User or True"},
+	}
+
+	for _, test := range tests {
+		if got := test.change.String(); got != test.want {
+			t.Errorf("String() = %q, want %q", got, test.want)
+		}
+	}
+}
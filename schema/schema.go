@@ -0,0 +1,146 @@
+// Package schema describes the types and methods of the Bot API supported by the telegram package, and checks the Go
+// structs against them.
+package schema
+
+import (
+	"bytes"
+	_ "embed" // The schema is embedded from botapi.json.
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+//go:embed botapi.json
+var botAPI []byte
+
+// Schema is a machine-readable description of the Bot API.
+type Schema struct {
+	Version string    `json:"version"` // Version of the Bot API, e.g. 3.6
+	Types   []*Type   `json:"types"`   // Types, in the order of the documentation
+	Methods []*Method `json:"methods"` // Methods, in the order of the documentation
+}
+
+// Type is an object of the Bot API.
+type Type struct {
+	Name        string   `json:"name"`               // Name of the type, e.g. Message
+	Description string   `json:"description"`        // Description of the type
+	Since       string   `json:"since,omitempty"`    // Optional. Version of the Bot API which added the type, empty if it is older than Bot API 2.0
+	Fields      []*Field `json:"fields,omitempty"`   // Fields of the type
+	Subtypes    []string `json:"subtypes,omitempty"` // Optional. Names of the types an abstract type can be, e.g. the results of InlineQueryResult
+}
+
+// Method is a method of the Bot API.
+type Method struct {
+	Name        string   `json:"name"`             // Name of the method, e.g. sendMessage
+	Description string   `json:"description"`      // Description of the method
+	Since       string   `json:"since,omitempty"`  // Optional. Version of the Bot API which added the method, empty if it is older than Bot API 2.0
+	Returns     []string `json:"returns"`          // Types the method can return, e.g. Message and True for editMessageText
+	Fields      []*Field `json:"fields,omitempty"` // Parameters of the method
+}
+
+// Field is a field of a type or a parameter of a method.
+type Field struct {
+	Name        string   `json:"name"`             // Name of the field, e.g. chat_id
+	Types       []string `json:"types"`            // Types the field can have, e.g. Integer and String for chat_id. Arrays are written as "Array of Type".
+	Required    bool     `json:"required"`         // True, if the field is always present
+	Description string   `json:"description"`      // Description of the field
+	Since       string   `json:"since,omitempty"`  // Optional. Version of the Bot API which added the field, empty if it is as old as its type or method
+	Values      []string `json:"values,omitempty"` // Optional. Values a String field can have, e.g. private, group, supergroup and channel for the type of a Chat
+	Limits      *Limits  `json:"limits,omitempty"` // Optional. Constraints on the value of a parameter, checked before sending the request
+}
+
+// Limits are the constraints the Bot API documents on the value of a parameter. The lengths of the strings are counted
+// in UTF-16 code units, after parsing the entities if the method has a parse mode.
+type Limits struct {
+	Min        *float64 `json:"min,omitempty"`         // Optional. Minimum of a number
+	Max        *float64 `json:"max,omitempty"`         // Optional. Maximum of a number
+	MinLength  int      `json:"min_length,omitempty"`  // Optional. Minimum length of a string
+	MaxLength  int      `json:"max_length,omitempty"`  // Optional. Maximum length of a string
+	MinBytes   int      `json:"min_bytes,omitempty"`   // Optional. Minimum length of a string in bytes
+	MaxBytes   int      `json:"max_bytes,omitempty"`   // Optional. Maximum length of a string in bytes
+	MinItems   int      `json:"min_items,omitempty"`   // Optional. Minimum number of the items of an array
+	MaxItems   int      `json:"max_items,omitempty"`   // Optional. Maximum number of the items of an array
+	Pattern    string   `json:"pattern,omitempty"`     // Optional. Regular expression a string must match
+	Format     string   `json:"format,omitempty"`      // Optional. Description of the pattern, e.g. "must end in _by_<bot username>"
+	AllowEmpty bool     `json:"allow_empty,omitempty"` // Optional. True, if a required string can be empty, e.g. the url of setWebhook to remove the webhook
+	Implies    string   `json:"implies,omitempty"`     // Optional. Boolean parameter which can not be False if the parameter is True
+}
+
+// Load returns the schema of the Bot API supported by the telegram package.
+func Load() (*Schema, error) {
+	return Parse(botAPI)
+}
+
+// Parse parses a schema from JSON.
+func Parse(data []byte) (*Schema, error) {
+	s := new(Schema)
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	return s, nil
+}
+
+// JSON returns the schema encoded as in botapi.json.
+func (s *Schema) JSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(s); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// Type returns the type with the name, or nil.
+func (s *Schema) Type(name string) *Type {
+	for _, t := range s.Types {
+		if t.Name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// Method returns the method with the name, or nil.
+func (s *Schema) Method(name string) *Method {
+	for _, m := range s.Methods {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Field returns the field with the name, or nil.
+func (t *Type) Field(name string) *Field {
+	return findField(t.Fields, name)
+}
+
+// Field returns the parameter with the name, or nil.
+func (m *Method) Field(name string) *Field {
+	return findField(m.Fields, name)
+}
+
+// TypeString returns the types of the field as written in the documentation, e.g. "Integer or String".
+func (f *Field) TypeString() string {
+	return strings.Join(f.Types, " or ")
+}
+
+// findField returns the field with the name, or nil.
+func findField(fields []*Field, name string) *Field {
+	for _, f := range fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
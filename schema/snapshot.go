@@ -0,0 +1,412 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// community is the format of the api.json snapshots of the Bot API published by the community, with the types and the
+// methods keyed by name and the descriptions split into paragraphs.
+type community struct {
+	Version string                     `json:"version"`
+	Types   map[string]communityObject `json:"types"`
+	Methods map[string]communityObject `json:"methods"`
+}
+
+// communityObject is a type or a method of a community snapshot.
+type communityObject struct {
+	Name        string           `json:"name"`
+	Description []string         `json:"description"`
+	Returns     []string         `json:"returns"`
+	Fields      []communityField `json:"fields"`
+	Subtypes    []string         `json:"subtypes"`
+}
+
+// communityField is a field of a community snapshot.
+type communityField struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+}
+
+// ParseSnapshot parses a snapshot of the Bot API reference: the HTML page of https://core.telegram.org/bots/api, a
+// JSON schema in the format of botapi.json, or a community api.json snapshot with the types and methods keyed by name.
+func ParseSnapshot(data []byte) (*Schema, error) {
+	trimmed := strings.TrimSpace(string(data))
+
+	if strings.HasPrefix(trimmed, "<") {
+		return ParseHTML(data)
+	}
+
+	var probe struct {
+		Types json.RawMessage `json:"types"`
+	}
+
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(string(probe.Types)), "{") {
+		return Parse(data)
+	}
+
+	c := community{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	s := &Schema{Version: strings.TrimPrefix(c.Version, "Bot API ")}
+
+	for _, name := range sortedKeys(c.Types) {
+		t := c.Types[name]
+		s.Types = append(s.Types, &Type{
+			Name:        name,
+			Description: strings.Join(t.Description, " "),
+			Fields:      communityFields(t.Fields),
+			Subtypes:    t.Subtypes,
+		})
+	}
+
+	for _, name := range sortedKeys(c.Methods) {
+		m := c.Methods[name]
+		s.Methods = append(s.Methods, &Method{
+			Name:        name,
+			Description: strings.Join(m.Description, " "),
+			Returns:     m.Returns,
+			Fields:      communityFields(m.Fields),
+		})
+	}
+
+	return s, nil
+}
+
+// communityFields returns the fields of a community snapshot.
+func communityFields(fields []communityField) []*Field {
+	result := []*Field{}
+
+	for _, f := range fields {
+		result = append(result, &Field{Name: f.Name, Types: f.Types, Required: f.Required, Description: f.Description})
+	}
+
+	return result
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(m map[string]communityObject) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+var (
+	htmlVersion  = regexp.MustCompile(`<strong>Bot API ([0-9.]+)</strong>`)
+	htmlHeading  = regexp.MustCompile(`(?s)<h([34])>(.*?)</h[34]>`)
+	htmlTable    = regexp.MustCompile(`(?s)<table class="table">(.*?)</table>`)
+	htmlRow      = regexp.MustCompile(`(?s)<tr>(.*?)</tr>`)
+	htmlCell     = regexp.MustCompile(`(?s)<td>(.*?)</td>`)
+	htmlList     = regexp.MustCompile(`(?s)<ul>(.*?)</ul>`)
+	htmlItem     = regexp.MustCompile(`(?s)<li>(.*?)</li>`)
+	htmlLink     = regexp.MustCompile(`(?s)^<a href="#[^"]*">([A-Za-z0-9]+)</a>$`)
+	htmlPara     = regexp.MustCompile(`(?s)<p>(.*?)</p>`)
+	htmlImage    = regexp.MustCompile(`<img[^>]*alt="([^"]*)"[^>]*>`)
+	htmlTag      = regexp.MustCompile(`<[^>]*>`)
+	htmlSpace    = regexp.MustCompile(`\s+`)
+	objectName   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+	returnedType = regexp.MustCompile(`(?i:(array of )(?:the sent )?)?\b([A-Z][A-Za-z0-9]*)\b`)
+)
+
+// ParseHTML parses the HTML page of the Bot API reference. The types and methods are the sections whose heading is a
+// single word, their fields are read from their tables, and the result types of the methods from their descriptions.
+func ParseHTML(data []byte) (*Schema, error) {
+	page := string(data)
+	s := &Schema{}
+
+	if m := htmlVersion.FindStringSubmatch(page); m != nil {
+		s.Version = m[1]
+	}
+
+	headings := htmlHeading.FindAllStringSubmatchIndex(page, -1)
+
+	for i, h := range headings {
+		if page[h[2]:h[3]] != "4" {
+			continue
+		}
+
+		name := htmlText(page[h[4]:h[5]])
+		if !objectName.MatchString(name) {
+			continue
+		}
+
+		end := len(page)
+		if i+1 < len(headings) {
+			end = headings[i+1][0]
+		}
+
+		section := page[h[1]:end]
+		description := htmlDescription(section)
+		fields := htmlFields(section)
+
+		if name[0] >= 'a' && name[0] <= 'z' {
+			s.Methods = append(s.Methods, &Method{Name: name, Description: description, Fields: fields})
+			continue
+		}
+
+		subtypes := htmlSubtypes(section, fields)
+
+		// The list of the subtypes is introduced by an unfinished sentence, e.g. "It should be one of".
+		if i := strings.LastIndex(description, ". "); len(subtypes) > 0 && i >= 0 && !strings.HasSuffix(description, ".") {
+			description = description[:i+1]
+		}
+
+		s.Types = append(s.Types, &Type{Name: name, Description: description, Fields: fields, Subtypes: subtypes})
+	}
+
+	if len(s.Types) == 0 && len(s.Methods) == 0 {
+		return nil, fmt.Errorf("schema: no types or methods in the HTML page")
+	}
+
+	known := map[string]bool{}
+	for _, t := range s.Types {
+		known[t.Name] = true
+	}
+
+	for _, m := range s.Methods {
+		m.Returns = htmlReturns(m.Description, known)
+	}
+
+	return s, nil
+}
+
+// htmlDescription returns the paragraphs of the section before its table or list.
+func htmlDescription(section string) string {
+	if i := strings.Index(section, "<table"); i >= 0 {
+		section = section[:i]
+	}
+
+	if i := strings.Index(section, "<ul>"); i >= 0 {
+		section = section[:i]
+	}
+
+	paragraphs := []string{}
+	for _, m := range htmlPara.FindAllStringSubmatch(section, -1) {
+		paragraphs = append(paragraphs, htmlText(m[1]))
+	}
+
+	return strings.Join(paragraphs, " ")
+}
+
+// htmlFields returns the fields of the table of the section. The tables of the methods have a Required column, the
+// optional fields of the types are described as Optional.
+func htmlFields(section string) []*Field {
+	table := htmlTable.FindStringSubmatch(section)
+	if table == nil {
+		return nil
+	}
+
+	fields := []*Field{}
+
+	for _, row := range htmlRow.FindAllStringSubmatch(table[1], -1) {
+		cells := htmlCell.FindAllStringSubmatch(row[1], -1)
+
+		switch len(cells) {
+		case 3:
+			description := htmlText(cells[2][1])
+			fields = append(fields, &Field{
+				Name:        htmlText(cells[0][1]),
+				Types:       htmlTypes(htmlText(cells[1][1])),
+				Required:    !strings.HasPrefix(description, "Optional"),
+				Description: description,
+			})
+		case 4:
+			fields = append(fields, &Field{
+				Name:        htmlText(cells[0][1]),
+				Types:       htmlTypes(htmlText(cells[1][1])),
+				Required:    htmlText(cells[2][1]) == "Yes",
+				Description: htmlText(cells[3][1]),
+			})
+		}
+	}
+
+	return fields
+}
+
+// htmlSubtypes returns the types listed by the section of an abstract type without fields.
+func htmlSubtypes(section string, fields []*Field) []string {
+	list := htmlList.FindStringSubmatch(section)
+	if list == nil || len(fields) > 0 {
+		return nil
+	}
+
+	subtypes := []string{}
+
+	for _, item := range htmlItem.FindAllStringSubmatch(list[1], -1) {
+		link := htmlLink.FindStringSubmatch(strings.TrimSpace(item[1]))
+		if link == nil {
+			return nil
+		}
+
+		subtypes = append(subtypes, link[1])
+	}
+
+	return subtypes
+}
+
+// htmlTypes splits a type column, e.g. "Integer or String" or "Array of InputMediaPhoto and InputMediaVideo".
+func htmlTypes(s string) []string {
+	array := ""
+	for {
+		rest, ok := strings.CutPrefix(s, "Array of ")
+		if !ok {
+			break
+		}
+
+		array += "Array of "
+		s = rest
+	}
+
+	types := []string{}
+
+	for _, part := range strings.FieldsFunc(strings.NewReplacer(" or ", ",", " and ", ",").Replace(s), func(r rune) bool { return r == ',' }) {
+		part = strings.TrimSpace(part)
+
+		switch part {
+		case "Int":
+			part = "Integer"
+		case "Float number":
+			part = "Float"
+		}
+
+		types = append(types, array+part)
+	}
+
+	return types
+}
+
+// htmlReturns returns the types the method returns according to the sentences of its description mentioning it.
+func htmlReturns(description string, known map[string]bool) []string {
+	returns := []string{}
+	seen := map[string]bool{}
+
+	for _, sentence := range strings.Split(description, ". ") {
+		if !strings.Contains(strings.ToLower(sentence), "return") {
+			continue
+		}
+
+		for _, m := range returnedType.FindAllStringSubmatch(sentence, -1) {
+			name := m[2]
+
+			switch {
+			case name == "Int":
+				name = "Integer"
+			case name == "True" || name == "String" || known[name]:
+			case strings.HasSuffix(name, "s") && known[strings.TrimSuffix(name, "s")]:
+				name = strings.TrimSuffix(name, "s")
+			default:
+				continue
+			}
+
+			if m[1] != "" {
+				name = "Array of " + name
+			}
+
+			if !seen[name] {
+				seen[name] = true
+				returns = append(returns, name)
+			}
+		}
+	}
+
+	return returns
+}
+
+// htmlText returns the text of an HTML fragment, with the emoji images replaced by their text.
+func htmlText(s string) string {
+	s = htmlImage.ReplaceAllString(s, "$1")
+	s = htmlTag.ReplaceAllString(s, "")
+
+	return strings.TrimSpace(htmlSpace.ReplaceAllString(html.UnescapeString(s), " "))
+}
+
+// Merge returns the next schema, imported from a snapshot, with the attributes only the current schema has: the values,
+// limits and versions of the fields, the versions of the types and methods, and the result types of the methods the
+// snapshot does not tell. The types, methods and fields the current schema does not have are marked as added by the
+// version of the next schema.
+func Merge(current, next *Schema) *Schema {
+	if next.Version == "" {
+		next.Version = current.Version
+	}
+
+	added := ""
+	if next.Version != current.Version {
+		added = next.Version
+	}
+
+	for _, t := range next.Types {
+		old := current.Type(t.Name)
+		if old == nil {
+			t.Since = since(t.Since, added)
+			continue
+		}
+
+		t.Since = since(t.Since, old.Since)
+		mergeFields(old.Fields, t.Fields, added)
+	}
+
+	for _, m := range next.Methods {
+		old := current.Method(m.Name)
+		if old == nil {
+			m.Since = since(m.Since, added)
+			continue
+		}
+
+		m.Since = since(m.Since, old.Since)
+		mergeFields(old.Fields, m.Fields, added)
+
+		if len(m.Returns) == 0 {
+			m.Returns = old.Returns
+		}
+	}
+
+	return next
+}
+
+// mergeFields copies the values, limits and versions of the current fields to the next fields of the same name, and marks the
+// other next fields as added by the version.
+func mergeFields(current, next []*Field, added string) {
+	for _, f := range next {
+		old := findField(current, f.Name)
+		if old == nil {
+			f.Since = since(f.Since, added)
+			continue
+		}
+
+		if len(f.Values) == 0 {
+			f.Values = old.Values
+		}
+
+		if f.Limits == nil {
+			f.Limits = old.Limits
+		}
+
+		f.Since = since(f.Since, old.Since)
+	}
+}
+
+// since returns the version of the snapshot, or the other version if the snapshot does not tell it.
+func since(snapshot, other string) string {
+	if snapshot != "" {
+		return snapshot
+	}
+
+	return other
+}
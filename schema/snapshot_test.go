@@ -0,0 +1,467 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// htmlSnapshot is a small part of the Bot API reference page, with the markup of https://core.telegram.org/bots/api.
+const htmlSnapshot = `<!DOCTYPE html>
+<html><body>
+<h3><a class="anchor" name="recent-changes" href="#recent-changes"><i class="anchor-icon"></i></a>Recent changes</h3>
+<h4><a class="anchor" name="july-26-2018" href="#july-26-2018"><i class="anchor-icon"></i></a>July 26, 2018</h4>
+<p><strong>Bot API 4.0</strong>.</p>
+<h3><a class="anchor" name="available-types" href="#available-types"><i class="anchor-icon"></i></a>Available types</h3>
+<h4><a class="anchor" name="user" href="#user"><i class="anchor-icon"></i></a>User</h4>
+<p>This object represents a Telegram user or bot.</p>
+<table class="table">
+<thead>
+<tr><th>Field</th><th>Type</th><th>Description</th></tr>
+</thead>
+<tbody>
+<tr><td>id</td><td>Integer</td><td>Unique identifier for this user or bot</td></tr>
+<tr><td>is_bot</td><td>Boolean</td><td>True, if this user is a bot</td></tr>
+<tr><td>username</td><td>String</td><td><em>Optional</em>. User&#39;s or bot&#39;s username</td></tr>
+</tbody>
+</table>
+<h4><a class="anchor" name="message" href="#message"><i class="anchor-icon"></i></a>Message</h4>
+<p>This object represents a message.</p>
+<table class="table">
+<tbody>
+<tr><td>message_id</td><td>Integer</td><td>Unique message identifier inside this chat</td></tr>
+<tr><td>from</td><td><a href="#user">User</a></td><td><em>Optional</em>. Sender, empty for messages sent to channels</td></tr>
+<tr><td>photo</td><td>Array of <a href="#photosize">PhotoSize</a></td><td><em>Optional</em>. Message is a photo, available sizes of the photo</td></tr>
+<tr><td>text</td><td>String</td><td><em>Optional</em>. Text <img class="emoji" src="//telegram.org/img/emoji/40/F09F9880.png" width="20" height="20" alt="😀" /> of the message</td></tr>
+</tbody>
+</table>
+<h4><a class="anchor" name="inputmedia" href="#inputmedia"><i class="anchor-icon"></i></a>InputMedia</h4>
+<p>This object represents the content of a media message to be sent. It should be one of</p>
+<ul>
+<li><a href="#inputmediaphoto">InputMediaPhoto</a></li>
+<li><a href="#inputmediavideo">InputMediaVideo</a></li>
+</ul>
+<h3><a class="anchor" name="available-methods" href="#available-methods"><i class="anchor-icon"></i></a>Available methods</h3>
+<h4><a class="anchor" name="getme" href="#getme"><i class="anchor-icon"></i></a>getMe</h4>
+<p>A simple method for testing your bot&#39;s auth token. Requires no parameters. Returns basic information about the bot in form of a <a href="#user">User</a> object.</p>
+<h4><a class="anchor" name="sendmessage" href="#sendmessage"><i class="anchor-icon"></i></a>sendMessage</h4>
+<p>Use this method to send text messages. On success, the sent <a href="#message">Message</a> is returned.</p>
+<table class="table">
+<tbody>
+<tr><td>chat_id</td><td>Integer or String</td><td>Yes</td><td>Unique identifier for the target chat</td></tr>
+<tr><td>text</td><td>String</td><td>Yes</td><td>Text of the message to be sent</td></tr>
+<tr><td>disable_notification</td><td>Boolean</td><td>Optional</td><td>Sends the message silently.</td></tr>
+</tbody>
+</table>
+<h4><a class="anchor" name="sendmediagroup" href="#sendmediagroup"><i class="anchor-icon"></i></a>sendMediaGroup</h4>
+<p>Use this method to send a group of photos or videos as an album. On success, an array of the sent Messages is returned.</p>
+<table class="table">
+<tbody>
+<tr><td>chat_id</td><td>Integer or String</td><td>Yes</td><td>Unique identifier for the target chat</td></tr>
+<tr><td>media</td><td>Array of <a href="#inputmediaphoto">InputMediaPhoto</a> and <a href="#inputmediavideo">InputMediaVideo</a></td><td>Yes</td><td>Photos and videos to be sent</td></tr>
+</tbody>
+</table>
+<h4><a class="anchor" name="getchatmemberscount" href="#getchatmemberscount"><i class="anchor-icon"></i></a>getChatMembersCount</h4>
+<p>Use this method to get the number of members in a chat. Returns <em>Int</em> on success.</p>
+<table class="table">
+<tbody>
+<tr><td>chat_id</td><td>Integer or String</td><td>Yes</td><td>Unique identifier for the target chat</td></tr>
+</tbody>
+</table>
+<h4><a class="anchor" name="kickchatmember" href="#kickchatmember"><i class="anchor-icon"></i></a>kickChatMember</h4>
+<p>Use this method to kick a user from a group.</p>
+<table class="table">
+<tbody>
+<tr><td>chat_id</td><td>Integer or String</td><td>Yes</td><td>Unique identifier for the target chat</td></tr>
+<tr><td>user_id</td><td>Integer</td><td>Yes</td><td>Unique identifier of the target user</td></tr>
+</tbody>
+</table>
+</body></html>
+`
+
+// communitySnapshot is a small community api.json snapshot.
+const communitySnapshot = `{
+  "version": "Bot API 4.0",
+  "types": {
+    "User": {
+      "name": "User",
+      "description": ["This object represents a Telegram user or bot."],
+      "fields": [
+        {"name": "id", "types": ["Integer"], "required": true, "description": "Unique identifier for this user or bot"},
+        {"name": "username", "types": ["String"], "required": false, "description": "Optional. User's or bot's username"}
+      ]
+    },
+    "InputMedia": {
+      "name": "InputMedia",
+      "description": ["This object represents the content of a media message to be sent.", "It should be one of"],
+      "subtypes": ["InputMediaPhoto", "InputMediaVideo"]
+    }
+  },
+  "methods": {
+    "getMe": {
+      "name": "getMe",
+      "description": ["A simple method for testing your bot's auth token."],
+      "returns": ["User"]
+    }
+  }
+}`
+
+// chatID is the chat_id parameter of the methods of htmlSnapshot.
+var chatID = &Field{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true, Description: "Unique identifier for the target chat"}
+
+// htmlSchema is the schema of htmlSnapshot.
+var htmlSchema = &Schema{
+	Version: "4.0",
+	Types: []*Type{
+		{
+			Name:        "User",
+			Description: "This object represents a Telegram user or bot.",
+			Fields: []*Field{
+				{Name: "id", Types: []string{"Integer"}, Required: true, Description: "Unique identifier for this user or bot"},
+				{Name: "is_bot", Types: []string{"Boolean"}, Required: true, Description: "True, if this user is a bot"},
+				{Name: "username", Types: []string{"String"}, Description: "Optional. User's or bot's username"},
+			},
+		},
+		{
+			Name:        "Message",
+			Description: "This object represents a message.",
+			Fields: []*Field{
+				{Name: "message_id", Types: []string{"Integer"}, Required: true, Description: "Unique message identifier inside this chat"},
+				{Name: "from", Types: []string{"User"}, Description: "Optional. Sender, empty for messages sent to channels"},
+				{Name: "photo", Types: []string{"Array of PhotoSize"}, Description: "Optional. Message is a photo, available sizes of the photo"},
+				{Name: "text", Types: []string{"String"}, Description: "Optional. Text 😀 of the message"},
+			},
+		},
+		{
+			Name:        "InputMedia",
+			Description: "This object represents the content of a media message to be sent.",
+			Subtypes:    []string{"InputMediaPhoto", "InputMediaVideo"},
+		},
+	},
+	Methods: []*Method{
+		{
+			Name:        "getMe",
+			Description: "A simple method for testing your bot's auth token. Requires no parameters. Returns basic information about the bot in form of a User object.",
+			Returns:     []string{"User"},
+		},
+		{
+			Name:        "sendMessage",
+			Description: "Use this method to send text messages. On success, the sent Message is returned.",
+			Returns:     []string{"Message"},
+			Fields: []*Field{
+				chatID,
+				{Name: "text", Types: []string{"String"}, Required: true, Description: "Text of the message to be sent"},
+				{Name: "disable_notification", Types: []string{"Boolean"}, Description: "Sends the message silently."},
+			},
+		},
+		{
+			Name:        "sendMediaGroup",
+			Description: "Use this method to send a group of photos or videos as an album. On success, an array of the sent Messages is returned.",
+			Returns:     []string{"Array of Message"},
+			Fields: []*Field{
+				chatID,
+				{Name: "media", Types: []string{"Array of InputMediaPhoto", "Array of InputMediaVideo"}, Required: true, Description: "Photos and videos to be sent"},
+			},
+		},
+		{
+			Name:        "getChatMembersCount",
+			Description: "Use this method to get the number of members in a chat. Returns Int on success.",
+			Returns:     []string{"Integer"},
+			Fields:      []*Field{chatID},
+		},
+		{
+			Name:        "kickChatMember",
+			Description: "Use this method to kick a user from a group.",
+			Returns:     []string{},
+			Fields: []*Field{
+				chatID,
+				{Name: "user_id", Types: []string{"Integer"}, Required: true, Description: "Unique identifier of the target user"},
+			},
+		},
+	},
+}
+
+func TestParseHTML(t *testing.T) {
+	s, err := ParseHTML([]byte(htmlSnapshot))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Version != htmlSchema.Version {
+		t.Errorf("Version = %q, want %q", s.Version, htmlSchema.Version)
+	}
+
+	for i, want := range htmlSchema.Types {
+		if i >= len(s.Types) || !reflect.DeepEqual(s.Types[i], want) {
+			t.Errorf("type %d = %+v, want %+v", i, at(s.Types, i), want)
+		}
+	}
+
+	for i, want := range htmlSchema.Methods {
+		if i >= len(s.Methods) || !reflect.DeepEqual(s.Methods[i], want) {
+			t.Errorf("method %d = %+v, want %+v", i, at(s.Methods, i), want)
+		}
+	}
+
+	if len(s.Types) != len(htmlSchema.Types) || len(s.Methods) != len(htmlSchema.Methods) {
+		t.Errorf("ParseHTML() has %d types and %d methods, want %d and %d", len(s.Types), len(s.Methods), len(htmlSchema.Types), len(htmlSchema.Methods))
+	}
+
+	if _, err := ParseHTML([]byte(`<html><h4>Recent changes</h4></html>`)); err == nil {
+		t.Error("ParseHTML() of a page without types and methods did not fail")
+	}
+}
+
+// at returns the element of the slice at the index, or nil.
+func at[T any](s []*T, i int) *T {
+	if i < len(s) {
+		return s[i]
+	}
+
+	return nil
+}
+
+func TestParseSnapshot(t *testing.T) {
+	s, err := ParseSnapshot([]byte("\n  " + htmlSnapshot))
+	if err != nil || len(s.Types) != len(htmlSchema.Types) || len(s.Methods) != len(htmlSchema.Methods) {
+		t.Errorf("ParseSnapshot() of HTML = %+v, %v, want the schema of the page", s, err)
+	}
+
+	s, err = ParseSnapshot([]byte(communitySnapshot))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Schema{
+		Version: "4.0",
+		Types: []*Type{
+			{
+				Name:        "InputMedia",
+				Description: "This object represents the content of a media message to be sent. It should be one of",
+				Fields:      []*Field{},
+				Subtypes:    []string{"InputMediaPhoto", "InputMediaVideo"},
+			},
+			{
+				Name:        "User",
+				Description: "This object represents a Telegram user or bot.",
+				Fields: []*Field{
+					{Name: "id", Types: []string{"Integer"}, Required: true, Description: "Unique identifier for this user or bot"},
+					{Name: "username", Types: []string{"String"}, Description: "Optional. User's or bot's username"},
+				},
+			},
+		},
+		Methods: []*Method{{
+			Name:        "getMe",
+			Description: "A simple method for testing your bot's auth token.",
+			Returns:     []string{"User"},
+			Fields:      []*Field{},
+		}},
+	}
+
+	if !reflect.DeepEqual(s, want) {
+		data, _ := s.JSON()
+		t.Errorf("ParseSnapshot() of a community snapshot = %s", data)
+	}
+
+	s, err = ParseSnapshot([]byte(`{"version":"3.6","types":[{"name":"User","description":"d"}],"methods":[]}`))
+	if err != nil || s.Version != "3.6" || s.Type("User") == nil {
+		t.Errorf("ParseSnapshot() of a schema = %+v, %v, want the schema", s, err)
+	}
+
+	for _, data := range []string{`{"types":`, `{"types":{"User":[]}}`} {
+		if _, err := ParseSnapshot([]byte(data)); err == nil {
+			t.Errorf("ParseSnapshot(%q) did not fail", data)
+		}
+	}
+}
+
+func TestHTMLFields(t *testing.T) {
+	section := `<p>A type.</p><table class="table"><tbody>` +
+		`<tr><td>required</td><td>Float number</td><td>A <em>required</em> field</td></tr>` +
+		`<tr><td>optional</td><td>Array of Array of <a href="#photosize">PhotoSize</a></td><td><em>Optional</em>. An optional field</td></tr>` +
+		`<tr><td>chat_id</td><td>Integer or String</td><td>Yes</td><td>A required parameter</td></tr>` +
+		`<tr><td>reply_markup</td><td>InlineKeyboardMarkup or ReplyKeyboardMarkup</td><td>Optional</td><td>An optional parameter</td></tr>` +
+		`<tr><td>ignored</td></tr>` +
+		`</tbody></table>`
+
+	want := []*Field{
+		{Name: "required", Types: []string{"Float"}, Required: true, Description: "A required field"},
+		{Name: "optional", Types: []string{"Array of Array of PhotoSize"}, Description: "Optional. An optional field"},
+		{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true, Description: "A required parameter"},
+		{Name: "reply_markup", Types: []string{"InlineKeyboardMarkup", "ReplyKeyboardMarkup"}, Description: "An optional parameter"},
+	}
+
+	if got := htmlFields(section); !reflect.DeepEqual(got, want) {
+		t.Errorf("htmlFields() = %+v, want %+v", got, want)
+	}
+
+	if got := htmlFields(`<p>No table.</p>`); got != nil {
+		t.Errorf("htmlFields() of a section without a table = %+v, want nil", got)
+	}
+}
+
+func TestHTMLTypes(t *testing.T) {
+	tests := []struct {
+		column string
+		want   []string
+	}{
+		{"Integer", []string{"Integer"}},
+		{"Int", []string{"Integer"}},
+		{"Float number", []string{"Float"}},
+		{"Integer or String", []string{"Integer", "String"}},
+		{"Array of PhotoSize", []string{"Array of PhotoSize"}},
+		{"Array of Array of InlineKeyboardButton", []string{"Array of Array of InlineKeyboardButton"}},
+		{"Array of InputMediaPhoto and InputMediaVideo", []string{"Array of InputMediaPhoto", "Array of InputMediaVideo"}},
+		{"InputFile or String", []string{"InputFile", "String"}},
+		{"InlineKeyboardMarkup, ReplyKeyboardMarkup or ForceReply", []string{"InlineKeyboardMarkup", "ReplyKeyboardMarkup", "ForceReply"}},
+	}
+
+	for _, test := range tests {
+		if got := htmlTypes(test.column); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("htmlTypes(%q) = %q, want %q", test.column, got, test.want)
+		}
+	}
+}
+
+func TestHTMLReturns(t *testing.T) {
+	known := map[string]bool{"Message": true, "User": true, "Update": true, "ChatMember": true}
+
+	tests := []struct {
+		description string
+		want        []string
+	}{
+		{"Use this method to send text messages. On success, the sent Message is returned.", []string{"Message"}},
+		{"On success, if edited message is sent by the bot, the edited Message is returned, otherwise True is returned.", []string{"Message", "True"}},
+		{"An Array of Update objects is returned.", []string{"Array of Update"}},
+		{"On success, an array of the sent Messages is returned.", []string{"Array of Message"}},
+		{"On success, returns an Array of ChatMember objects that contains information about all chat administrators.", []string{"Array of ChatMember"}},
+		{"Returns Int on success.", []string{"Integer"}},
+		{"Returns the new invite link as String on success.", []string{"String"}},
+		{"Use this method to get a User. A Message sent by it is a service message. Returns True on success.", []string{"True"}},
+		{"Use this method to kick a user from a group.", []string{}},
+	}
+
+	for _, test := range tests {
+		if got := htmlReturns(test.description, known); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("htmlReturns(%q) = %q, want %q", test.description, got, test.want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	maxLength := 4096
+
+	current := &Schema{
+		Version: "3.6",
+		Types: []*Type{
+			{Name: "User", Fields: []*Field{
+				{Name: "id", Types: []string{"Integer"}, Required: true},
+				{Name: "is_bot", Types: []string{"Boolean"}, Required: true, Since: "3.1"},
+				{Name: "username", Types: []string{"String"}},
+			}},
+			{Name: "Message", Fields: []*Field{
+				{Name: "message_id", Types: []string{"Integer"}, Required: true},
+				{Name: "from", Types: []string{"User"}},
+				{Name: "text", Types: []string{"String"}},
+			}},
+			{Name: "InputMedia", Since: "3.5", Subtypes: []string{"InputMediaPhoto", "InputMediaVideo"}},
+		},
+		Methods: []*Method{
+			{Name: "getMe", Returns: []string{"User"}},
+			{Name: "sendMessage", Returns: []string{"Message"}, Fields: []*Field{
+				{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true},
+				{Name: "text", Types: []string{"String"}, Required: true, Limits: &Limits{MinLength: 1, MaxLength: maxLength}},
+				{Name: "parse_mode", Types: []string{"String"}, Values: []string{"Markdown", "HTML"}},
+			}},
+			{Name: "kickChatMember", Returns: []string{"True"}, Fields: []*Field{
+				{Name: "chat_id", Types: []string{"Integer", "String"}, Required: true},
+				{Name: "user_id", Types: []string{"Integer"}, Required: true},
+			}},
+		},
+	}
+
+	next, err := ParseHTML([]byte(htmlSnapshot))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next.Method("sendMessage").Fields = append(next.Method("sendMessage").Fields, &Field{Name: "parse_mode", Types: []string{"String"}})
+
+	merged := Merge(current, next)
+	if merged.Version != "4.0" {
+		t.Errorf("Version = %q, want 4.0", merged.Version)
+	}
+
+	versions := map[string]string{
+		"User":                 "",
+		"User.id":              "",
+		"User.is_bot":          "3.1",
+		"Message.photo":        "4.0",
+		"Message.text":         "",
+		"InputMedia":           "3.5",
+		"getMe":                "",
+		"sendMessage.text":     "",
+		"sendMediaGroup":       "4.0",
+		"sendMediaGroup.media": "",
+		"getChatMembersCount":  "4.0",
+	}
+
+	for name, want := range versions {
+		if got := sinceOf(merged, name); got != want {
+			t.Errorf("%s since %q, want %q", name, got, want)
+		}
+	}
+
+	sendMessage := merged.Method("sendMessage")
+	if limits := sendMessage.Field("text").Limits; limits == nil || limits.MaxLength != maxLength {
+		t.Errorf("sendMessage.text limits = %+v, want the current limits", limits)
+	}
+
+	if values := sendMessage.Field("parse_mode").Values; !reflect.DeepEqual(values, []string{"Markdown", "HTML"}) {
+		t.Errorf("sendMessage.parse_mode values = %q, want the current values", values)
+	}
+
+	if returns := merged.Method("kickChatMember").Returns; !reflect.DeepEqual(returns, []string{"True"}) {
+		t.Errorf("kickChatMember returns %q, want the current result", returns)
+	}
+
+	if returns := merged.Method("sendMessage").Returns; !reflect.DeepEqual(returns, []string{"Message"}) {
+		t.Errorf("sendMessage returns %q, want Message", returns)
+	}
+
+	// A snapshot of the same version, or without a version, adds nothing new to it
+	for _, version := range []string{"3.6", ""} {
+		next, err := ParseHTML([]byte(htmlSnapshot))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		next.Version = version
+
+		merged := Merge(current, next)
+		if merged.Version != "3.6" || merged.Method("sendMediaGroup").Since != "" || merged.Type("Message").Field("photo").Since != "" {
+			t.Errorf("Merge() of version %q = version %q, sendMediaGroup since %q, want 3.6 and no new versions", version, merged.Version,
+				merged.Method("sendMediaGroup").Since)
+		}
+	}
+}
+
+// sinceOf returns the version of the type, method or field of the schema, named as e.g. Message or Message.photo.
+func sinceOf(s *Schema, name string) string {
+	object, field, _ := strings.Cut(name, ".")
+
+	if t := s.Type(object); t != nil {
+		if field == "" {
+			return t.Since
+		}
+
+		return t.Field(field).Since
+	}
+
+	m := s.Method(object)
+	if field == "" {
+		return m.Since
+	}
+
+	return m.Field(field).Since
+}
@@ -0,0 +1,211 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxMessageLength is the maximum length of the text of a message, in UTF-16 code units after parsing the entities.
+	MaxMessageLength = 4096
+	// MaxCaptionLength is the maximum length of a caption, in UTF-16 code units after parsing the entities.
+	MaxCaptionLength = 200
+)
+
+// TextPart is a part of a split text.
+type TextPart struct {
+	Text     string          // Text of the part
+	Entities []MessageEntity // Entities of the part, relative to its start
+}
+
+// SplitText splits the text into parts of at most limit UTF-16 code units, preferring to split it at paragraphs, then at
+// lines, then at spaces, outside the entities if possible, and never inside a surrogate pair. An entity split between two
+// parts continues in the next part. The parts are trimmed, unless the text is within the limit.
+func SplitText(text string, entities []MessageEntity, limit int64) []TextPart {
+	if UTF16Len(text) <= limit {
+		return []TextPart{{Text: text, Entities: entities}}
+	}
+
+	parts := []TextPart{}
+	offset, total := int64(0), UTF16Len(text)
+
+	for text != "" {
+		rest := clipEntities(entities, offset, total)
+
+		cut := len(text)
+		if UTF16Len(text) > limit {
+			cut = splitPoint(text, rest, limit)
+		}
+
+		units := UTF16Len(text[:cut])
+
+		part, partEntities := TrimText(text[:cut], clipEntities(rest, 0, units))
+		if part != "" {
+			parts = append(parts, TextPart{Text: part, Entities: partEntities})
+		}
+
+		text = text[cut:]
+		offset += units
+	}
+
+	return parts
+}
+
+// SplitMessage splits the text of the message into messages within MaxMessageLength, parsing the text according to its
+// parse mode and writing the parts in the same mode, so the formatting continues in the next message. The first message
+// replies to ReplyToMessageID and the last one has the ReplyMarkup.
+func SplitMessage(message SendMessage) ([]SendMessage, error) {
+	parts, err := splitFormatted(message.Text, message.ParseMode, MaxMessageLength)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]SendMessage, len(parts))
+
+	for i, part := range parts {
+		messages[i] = message
+		messages[i].Text = part
+
+		if i > 0 {
+			messages[i].ReplyToMessageID = nil
+		}
+
+		if i < len(parts)-1 {
+			messages[i].ReplyMarkup = nil
+		}
+	}
+
+	return messages, nil
+}
+
+// SplitCaption checks the length of a caption written in the parse mode. If it is within MaxCaptionLength, it returns the
+// caption. Otherwise it returns no caption, and the messages to send the caption in after the media, e.g.
+// photo.Caption, messages, err = SplitCaption(photo.ChatID, photo.Caption, photo.ParseMode).
+func SplitCaption(chatID string, caption, parseMode *string) (*string, []SendMessage, error) {
+	if caption == nil {
+		return nil, nil, nil
+	}
+
+	text, _, err := parseFormatted(*caption, parseMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if UTF16Len(text) <= MaxCaptionLength {
+		return caption, nil, nil
+	}
+
+	messages, err := SplitMessage(SendMessage{ChatID: chatID, Text: *caption, ParseMode: parseMode})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, messages, nil
+}
+
+// splitFormatted splits a text written in the parse mode into parts written in the same mode.
+func splitFormatted(s string, parseMode *string, limit int64) ([]string, error) {
+	text, entities, err := parseFormatted(s, parseMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if UTF16Len(text) <= limit {
+		return []string{s}, nil
+	}
+
+	parts := []string{}
+
+	for _, part := range SplitText(text, entities, limit) {
+		switch {
+		case parseMode == nil || *parseMode == "":
+			parts = append(parts, part.Text)
+		case strings.EqualFold(*parseMode, HTML):
+			parts = append(parts, RenderHTML(part.Text, part.Entities))
+		default:
+			parts = append(parts, RenderMarkdown(part.Text, part.Entities))
+		}
+	}
+
+	return parts, nil
+}
+
+// parseFormatted returns the text and the entities of a text written in the parse mode.
+func parseFormatted(s string, parseMode *string) (string, []MessageEntity, error) {
+	switch {
+	case parseMode == nil || *parseMode == "":
+		return s, nil, nil
+	case strings.EqualFold(*parseMode, HTML):
+		return ParseHTML(s)
+	case strings.EqualFold(*parseMode, Markdown):
+		return ParseMarkdown(s)
+	}
+
+	return "", nil, fmt.Errorf("telegram: unsupported parse mode %q", *parseMode)
+}
+
+// splitPoint returns the byte offset to split the text at to keep the limit. It prefers the end of a paragraph, then of a
+// line, then a space, outside the entities, then the same inside the entities, then any character outside and inside the
+// entities. Entities longer than the limit are split anyway, so they are ignored.
+func splitPoint(text string, entities []MessageEntity, limit int64) int {
+	spans := [][2]int{}
+
+	for _, entity := range entities {
+		if entity.Length > limit {
+			continue
+		}
+
+		if start, end, ok := utf16Range(text, entity.Offset, entity.Length); ok {
+			spans = append(spans, [2]int{start, end})
+		}
+	}
+
+	// best holds the last split point of each kind: paragraph, line, space and character outside the entities, then the
+	// same inside the entities.
+	best := [8]int{}
+	units := int64(0)
+
+	for i, r := range text {
+		if i > 0 {
+			level := 3
+
+			switch {
+			case strings.HasSuffix(text[:i], "\n\n"):
+				level = 0
+			case strings.HasSuffix(text[:i], "\n"):
+				level = 1
+			default:
+				if last, _ := utf8.DecodeLastRuneInString(text[:i]); unicode.IsSpace(last) {
+					level = 2
+				}
+			}
+
+			for _, span := range spans {
+				if span[0] < i && i < span[1] {
+					level += 4
+					break
+				}
+			}
+
+			best[level] = i
+		}
+
+		units += utf16RuneLen(r)
+		if units > limit {
+			break
+		}
+	}
+
+	for _, kind := range []int{0, 1, 2, 4, 5, 6, 3, 7} {
+		if best[kind] > 0 {
+			return best[kind]
+		}
+	}
+
+	// The first character alone exceeds the limit.
+	_, size := utf8.DecodeRuneInString(text)
+
+	return size
+}
@@ -0,0 +1,174 @@
+package telegram
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// samePart reports whether the parts have the same text and entities, an empty list of entities being the same as none.
+func samePart(a, b TextPart) bool {
+	if a.Text != b.Text || len(a.Entities) != len(b.Entities) {
+		return false
+	}
+
+	for i := range a.Entities {
+		if a.Entities[i] != b.Entities[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSplitText(t *testing.T) {
+	bold := func(offset, length int64) []MessageEntity {
+		return []MessageEntity{{Type: Bold, Offset: offset, Length: length}}
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		entities []MessageEntity
+		limit    int64
+		want     []TextPart
+	}{
+		{"within the limit", "  short  ", nil, 20, []TextPart{{Text: "  short  "}}},
+		{"paragraphs", "aaa bbb\nccc ddd\n\neee fff", nil, 12, []TextPart{{Text: "aaa bbb"}, {Text: "ccc ddd"}, {Text: "eee fff"}}},
+		{"lines", "aaa bbb\nccc ddd", nil, 10, []TextPart{{Text: "aaa bbb"}, {Text: "ccc ddd"}}},
+		{"spaces", "aaa bbb ccc", nil, 8, []TextPart{{Text: "aaa bbb"}, {Text: "ccc"}}},
+		{"long word", "aaa bbbbbbbbbb", nil, 6, []TextPart{{Text: "aaa"}, {Text: "bbbbbb"}, {Text: "bbbb"}}},
+		{"outside an entity", "ab cd ef", bold(0, 5), 6, []TextPart{{Text: "ab cd", Entities: bold(0, 5)}, {Text: "ef"}}},
+		{"around an entity", "abcdefgh", bold(2, 4), 4, []TextPart{{Text: "ab"}, {Text: "cdef", Entities: bold(0, 4)}, {Text: "gh"}}},
+		{"long entity", "aa bbbbbb", bold(3, 6), 5, []TextPart{{Text: "aa"}, {Text: "bbbbb", Entities: bold(0, 5)}, {Text: "b", Entities: bold(0, 1)}}},
+		{"surrogate pairs", "😀😀😀", nil, 3, []TextPart{{Text: "😀"}, {Text: "😀"}, {Text: "😀"}}},
+		{"surrogate pair at the limit", "ab😀cd", nil, 3, []TextPart{{Text: "ab"}, {Text: "😀c"}, {Text: "d"}}},
+		{"entity after surrogate pairs", "😀😀 bold", bold(5, 4), 6, []TextPart{{Text: "😀😀"}, {Text: "bold", Entities: bold(0, 4)}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := SplitText(test.text, test.entities, test.limit)
+
+			if len(got) != len(test.want) {
+				t.Fatalf("SplitText() = %+v, want %+v", got, test.want)
+			}
+
+			for i := range got {
+				if !samePart(got[i], test.want[i]) {
+					t.Errorf("part %d = %+v, want %+v", i, got[i], test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitMessage(t *testing.T) {
+	long := strings.Repeat("x", MaxMessageLength-1)
+
+	tests := []struct {
+		name      string
+		text      string
+		parseMode *string
+		want      []string
+	}{
+		{"at the limit", strings.Repeat("x", MaxMessageLength), nil, []string{strings.Repeat("x", MaxMessageLength)}},
+		{"surrogate pairs at the limit", strings.Repeat("😀", MaxMessageLength/2), nil, []string{strings.Repeat("😀", MaxMessageLength/2)}},
+		{"surrogate pair after the limit", long + "😀", nil, []string{long, "😀"}},
+		{"space", long + " y", nil, []string{long, "y"}},
+		{"formatted at the limit", "<b>" + strings.Repeat("x", MaxMessageLength) + "</b>", ptr(HTML), []string{"<b>" + strings.Repeat("x", MaxMessageLength) + "</b>"}},
+		{"html", "<b>" + long + " bold</b> &amp; plain", ptr(HTML), []string{"<b>" + long + "</b>", "<b>bold</b> &amp; plain"}},
+		{"markdown", "_" + long + " italic_ plain", ptr(Markdown), []string{"_" + long + "_", "_italic_ plain"}},
+		{"markdown brackets", long[4:] + " [1] note", ptr(Markdown), []string{long[4:] + " \\[1]", "note"}},
+		{"unparsed", "<b>" + long + "</b>", nil, []string{"<b>" + long[:MaxMessageLength-3], long[MaxMessageLength-3:] + "</b>"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			messages, err := SplitMessage(SendMessage{ChatID: "1", Text: test.text, ParseMode: test.parseMode})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(messages) != len(test.want) {
+				t.Fatalf("SplitMessage() returned %d messages, want %d", len(messages), len(test.want))
+			}
+
+			for i, message := range messages {
+				if message.Text != test.want[i] {
+					t.Errorf("message %d = %.40q... (%d bytes), want %.40q... (%d bytes)", i, message.Text, len(message.Text), test.want[i], len(test.want[i]))
+				}
+
+				if !utf8.ValidString(message.Text) {
+					t.Errorf("message %d splits a character", i)
+				}
+
+				if err := message.Validate(); err != nil {
+					t.Errorf("message %d: %v", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitMessageOptions(t *testing.T) {
+	markup := &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{CallbackButton("ok", "ok")}}}
+	message := SendMessage{
+		ChatID:           "1",
+		Text:             strings.Repeat("word ", MaxMessageLength),
+		ReplyToMessageID: ptr(int64(3)),
+		ReplyMarkup:      markup,
+	}
+
+	messages, err := SplitMessage(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 6 {
+		t.Fatalf("SplitMessage() returned %d messages, want 6", len(messages))
+	}
+
+	for i, m := range messages {
+		if first := m.ReplyToMessageID != nil; first != (i == 0) {
+			t.Errorf("message %d replies: %v", i, first)
+		}
+
+		if last := m.ReplyMarkup != nil; last != (i == len(messages)-1) {
+			t.Errorf("message %d has the markup: %v", i, last)
+		}
+	}
+
+	if _, err := SplitMessage(SendMessage{Text: "hi", ParseMode: ptr("BBCode")}); err == nil {
+		t.Error("SplitMessage() of an unknown parse mode did not fail")
+	}
+
+	if _, err := SplitMessage(SendMessage{Text: "<b>open", ParseMode: ptr(HTML)}); err == nil {
+		t.Error("SplitMessage() of invalid HTML did not fail")
+	}
+}
+
+func TestSplitCaption(t *testing.T) {
+	short := "<b>" + strings.Repeat("x", MaxCaptionLength) + "</b>"
+	long := strings.Repeat("x", MaxCaptionLength) + " y"
+
+	tests := []struct {
+		name      string
+		caption   *string
+		parseMode *string
+		want      *string // Returned caption
+		messages  int
+	}{
+		{"no caption", nil, nil, nil, 0},
+		{"formatted within the limit", &short, ptr(HTML), &short, 0},
+		{"long", &long, nil, nil, 1},
+		{"long with markup", ptr(long + "<i></i>"), ptr(HTML), nil, 1},
+	}
+
+	for _, test := range tests {
+		caption, messages, err := SplitCaption("1", test.caption, test.parseMode)
+		if err != nil || caption != test.want || len(messages) != test.messages {
+			t.Errorf("%s: SplitCaption() = %v, %d messages, %v", test.name, caption, len(messages), err)
+		}
+	}
+}
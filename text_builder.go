@@ -0,0 +1,98 @@
+package telegram
+
+import "strings"
+
+// TextBuilder builds the text of a message or a caption from plain and formatted parts, without having to escape them, e.g.
+// new(TextBuilder).Text("Hello, ").Mention(user).Text("! Visit ").Link("our site", "https://example.com").
+// The zero value is an empty text.
+type TextBuilder struct {
+	text     strings.Builder
+	units    int64
+	entities []MessageEntity
+}
+
+// Text appends plain text.
+func (b *TextBuilder) Text(text string) *TextBuilder {
+	return b.append(text, MessageEntity{})
+}
+
+// Bold appends bold text.
+func (b *TextBuilder) Bold(text string) *TextBuilder {
+	return b.append(text, MessageEntity{Type: Bold})
+}
+
+// Italic appends italic text.
+func (b *TextBuilder) Italic(text string) *TextBuilder {
+	return b.append(text, MessageEntity{Type: Italic})
+}
+
+// Code appends monowidth text.
+func (b *TextBuilder) Code(text string) *TextBuilder {
+	return b.append(text, MessageEntity{Type: Code})
+}
+
+// Pre appends a monowidth block.
+func (b *TextBuilder) Pre(text string) *TextBuilder {
+	return b.append(text, MessageEntity{Type: Pre})
+}
+
+// Link appends text opening the url.
+func (b *TextBuilder) Link(text, url string) *TextBuilder {
+	return b.append(text, MessageEntity{Type: TextLink, URL: &url})
+}
+
+// Mention appends the name of the user, mentioning the user.
+func (b *TextBuilder) Mention(user User) *TextBuilder {
+	name := user.FirstName
+	if user.LastName != nil && *user.LastName != "" {
+		name += " " + *user.LastName
+	}
+
+	return b.MentionText(name, user)
+}
+
+// MentionText appends text mentioning the user.
+func (b *TextBuilder) MentionText(text string, user User) *TextBuilder {
+	return b.append(text, MessageEntity{Type: TextMention, User: &user})
+}
+
+// String returns the plain text, without formatting.
+func (b *TextBuilder) String() string {
+	return b.text.String()
+}
+
+// Entities returns the plain text with the entities of its formatted parts.
+func (b *TextBuilder) Entities() (string, []MessageEntity) {
+	return b.text.String(), append([]MessageEntity(nil), b.entities...)
+}
+
+// HTML returns the text written as Telegram HTML with the HTML parse mode.
+func (b *TextBuilder) HTML() (string, string) {
+	return RenderHTML(b.text.String(), b.entities), HTML
+}
+
+// Markdown returns the text written as Telegram Markdown with the Markdown parse mode. As in RenderMarkdown, a pre block
+// containing ``` or a link containing ] is written as plain text, since Markdown can not express it; HTML can.
+func (b *TextBuilder) Markdown() (string, string) {
+	return RenderMarkdown(b.text.String(), b.entities), Markdown
+}
+
+// Len returns the length of the text in UTF-16 code units, as counted by the length limits of Telegram.
+func (b *TextBuilder) Len() int64 {
+	return b.units
+}
+
+// append appends the text, formatted with the entity unless it has no type.
+func (b *TextBuilder) append(text string, entity MessageEntity) *TextBuilder {
+	length := UTF16Len(text)
+
+	if entity.Type != "" && length > 0 {
+		entity.Offset, entity.Length = b.units, length
+		b.entities = append(b.entities, entity)
+	}
+
+	b.text.WriteString(text)
+	b.units += length
+
+	return b
+}
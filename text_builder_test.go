@@ -0,0 +1,91 @@
+package telegram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextBuilder(t *testing.T) {
+	user := User{ID: 5, FirstName: "Jo😀", LastName: ptr("Doe")}
+	url := "http://example.com/?a=1&b=2"
+
+	b := new(TextBuilder).
+		Text("a_b <x> & ").
+		Bold("*s*").
+		Text(" ").
+		Mention(user).
+		Text(", ").
+		Link("site", url).
+		Italic("").
+		Code("x").
+		Pre("p")
+
+	if want := "a_b <x> & *s* Jo😀 Doe, sitexp"; b.String() != want {
+		t.Errorf("String() = %q, want %q", b.String(), want)
+	}
+
+	if b.Len() != 30 {
+		t.Errorf("Len() = %d, want 30", b.Len())
+	}
+
+	wantEntities := []MessageEntity{
+		{Type: Bold, Offset: 10, Length: 3},
+		{Type: TextMention, Offset: 14, Length: 8, User: &user},
+		{Type: TextLink, Offset: 24, Length: 4, URL: &url},
+		{Type: Code, Offset: 28, Length: 1},
+		{Type: Pre, Offset: 29, Length: 1},
+	}
+
+	text, entities := b.Entities()
+	if text != b.String() || !reflect.DeepEqual(entities, wantEntities) {
+		t.Errorf("Entities() = %q, %+v, want %+v", text, entities, wantEntities)
+	}
+
+	html, mode := b.HTML()
+	if want := `a_b &lt;x&gt; &amp; <b>*s*</b> <a href="tg://user?id=5">Jo😀 Doe</a>, <a href="http://example.com/?a=1&amp;b=2">site</a><code>x</code><pre>p</pre>`; html != want || mode != HTML {
+		t.Errorf("HTML() = %q, %q, want %q", html, mode, want)
+	}
+
+	parsed, parsedEntities, err := ParseHTML(html)
+	if err != nil || parsed != text || len(parsedEntities) != len(entities) {
+		t.Errorf("ParseHTML(HTML()) = %q, %+v, %v, want the text and its entities", parsed, parsedEntities, err)
+	}
+}
+
+func TestTextBuilderMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *TextBuilder
+		markdown string
+	}{
+		{"empty", new(TextBuilder), ""},
+		{"plain", new(TextBuilder).Text("1*2_3"), `1\*2\_3`},
+		{"formatted", new(TextBuilder).Bold("bold").Text(" ").Italic("italic").Text(" ").Code("a_b"), "*bold* _italic_ `a_b`"},
+		{"link", new(TextBuilder).Text("see ").Link("docs", "https://example.com"), "see [docs](https://example.com)"},
+		{"mention", new(TextBuilder).Mention(User{ID: 7, FirstName: "Ann"}), "[Ann](tg://user?id=7)"},
+	}
+
+	for _, test := range tests {
+		markdown, mode := test.builder.Markdown()
+		if markdown != test.markdown || mode != Markdown {
+			t.Errorf("%s: Markdown() = %q, %q, want %q", test.name, markdown, mode, test.markdown)
+			continue
+		}
+
+		text, entities, err := ParseMarkdown(markdown)
+		if wantText, wantEntities := test.builder.Entities(); err != nil || text != wantText || len(entities) != len(wantEntities) {
+			t.Errorf("%s: ParseMarkdown(Markdown()) = %q, %+v, %v, want %q, %+v", test.name, text, entities, err, wantText, wantEntities)
+		}
+	}
+}
+
+func TestTextBuilderEntitiesCopy(t *testing.T) {
+	b := new(TextBuilder).Bold("a")
+
+	_, entities := b.Entities()
+	entities[0].Type = Italic
+
+	if _, entities := b.Entities(); entities[0].Type != Bold {
+		t.Errorf("Entities() shares the entities of the builder")
+	}
+}
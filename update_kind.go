@@ -0,0 +1,27 @@
+package telegram
+
+// UpdateKind is the kind of an update, named after the optional field of Update that is present.
+type UpdateKind string
+
+const (
+	// UnknownUpdate is the kind of an update without any known optional field.
+	UnknownUpdate UpdateKind = ""
+	// MessageUpdate is a kind of update.
+	MessageUpdate UpdateKind = "message"
+	// EditedMessageUpdate is a kind of update.
+	EditedMessageUpdate UpdateKind = "edited_message"
+	// ChannelPostUpdate is a kind of update.
+	ChannelPostUpdate UpdateKind = "channel_post"
+	// EditedChannelPostUpdate is a kind of update.
+	EditedChannelPostUpdate UpdateKind = "edited_channel_post"
+	// InlineQueryUpdate is a kind of update.
+	InlineQueryUpdate UpdateKind = "inline_query"
+	// ChosenInlineResultUpdate is a kind of update.
+	ChosenInlineResultUpdate UpdateKind = "chosen_inline_result"
+	// CallbackQueryUpdate is a kind of update.
+	CallbackQueryUpdate UpdateKind = "callback_query"
+	// ShippingQueryUpdate is a kind of update.
+	ShippingQueryUpdate UpdateKind = "shipping_query"
+	// PreCheckoutQueryUpdate is a kind of update.
+	PreCheckoutQueryUpdate UpdateKind = "pre_checkout_query"
+)
@@ -0,0 +1,143 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEditMethodsValidate(t *testing.T) {
+	chatID, messageID, inlineMessageID := "1", int64(2), "m"
+
+	addresses := []struct {
+		name            string
+		chatID          *string
+		messageID       *int64
+		inlineMessageID *string
+		valid           bool
+	}{
+		{"chat message", &chatID, &messageID, nil, true},
+		{"inline message", nil, nil, &inlineMessageID, true},
+		{"no address", nil, nil, nil, false},
+		{"chat only", &chatID, nil, nil, false},
+		{"message only", nil, &messageID, nil, false},
+		{"both forms", &chatID, &messageID, &inlineMessageID, false},
+		{"inline message in a chat", &chatID, nil, &inlineMessageID, false},
+	}
+
+	methods := map[string]func(chatID *string, messageID *int64, inlineMessageID *string) Validator{
+		"editMessageText": func(chatID *string, messageID *int64, inlineMessageID *string) Validator {
+			return EditMessageText{ChatID: chatID, MessageID: messageID, InlineMessageID: inlineMessageID, Text: "t"}
+		},
+		"editMessageCaption": func(chatID *string, messageID *int64, inlineMessageID *string) Validator {
+			return EditMessageCaption{ChatID: chatID, MessageID: messageID, InlineMessageID: inlineMessageID}
+		},
+		"editMessageMedia": func(chatID *string, messageID *int64, inlineMessageID *string) Validator {
+			return EditMessageMedia{ChatID: chatID, MessageID: messageID, InlineMessageID: inlineMessageID, Media: InputMediaPhoto{Media: FileID("p")}}
+		},
+		"editMessageReplyMarkup": func(chatID *string, messageID *int64, inlineMessageID *string) Validator {
+			return EditMessageReplyMarkup{ChatID: chatID, MessageID: messageID, InlineMessageID: inlineMessageID}
+		},
+	}
+
+	for name, method := range methods {
+		for _, address := range addresses {
+			err := method(address.chatID, address.messageID, address.inlineMessageID).Validate()
+			if address.valid && err != nil {
+				t.Errorf("%s with %s: Validate() = %v", name, address.name, err)
+			}
+
+			if !address.valid && (!errors.Is(err, ErrMessageAddress) || !strings.Contains(err.Error(), name)) {
+				t.Errorf("%s with %s: Validate() = %v, want ErrMessageAddress", name, address.name, err)
+			}
+		}
+	}
+}
+
+func TestEditMethodsEncode(t *testing.T) {
+	chatID, messageID, inlineMessageID, caption := "1", int64(2), "m", "new"
+
+	tests := []struct {
+		method Method
+		want   map[string]interface{} // Set parameters of the JSON request
+	}{
+		{
+			EditMessageCaption{ChatID: &chatID, MessageID: &messageID, Caption: &caption},
+			map[string]interface{}{"chat_id": "1", "message_id": 2.0, "caption": "new"},
+		},
+		{
+			EditMessageMedia{InlineMessageID: &inlineMessageID, Media: &InputMediaVideo{Media: FileURL("https://example.com/v.mp4")}},
+			map[string]interface{}{"inline_message_id": "m", "media": map[string]interface{}{"type": "video", "media": "https://example.com/v.mp4"}},
+		},
+		{
+			DeleteMessage{ChatID: "@channel", MessageID: 3},
+			map[string]interface{}{"chat_id": "@channel", "message_id": 3.0},
+		},
+	}
+
+	for _, test := range tests {
+		body, contentType, err := EncodeRequest(test.method)
+		if err != nil || contentType != "application/json" {
+			t.Errorf("EncodeRequest(%T) = %q, %v, want JSON", test.method, contentType, err)
+			continue
+		}
+
+		data, err := io.ReadAll(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := map[string]interface{}{}
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		removeNulls(got)
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("EncodeRequest(%T) = %s, want %v", test.method, data, test.want)
+		}
+	}
+}
+
+// removeNulls removes the null values from the JSON object and the objects in it.
+func removeNulls(object map[string]interface{}) {
+	for key, value := range object {
+		switch value := value.(type) {
+		case nil:
+			delete(object, key)
+		case map[string]interface{}:
+			removeNulls(value)
+		}
+	}
+}
+
+func TestEditMessageMediaUpload(t *testing.T) {
+	chatID, messageID := "1", int64(2)
+
+	fields, files := readForm(t, EditMessageMedia{ChatID: &chatID, MessageID: &messageID, Media: InputMediaPhoto{
+		Media: FileReader{Name: "a.jpg", Reader: strings.NewReader("JPEG")},
+	}})
+
+	media := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields["media"]), &media); err != nil {
+		t.Fatal(err)
+	}
+
+	if media["type"] != "photo" || media["media"] != "attach://media_file" {
+		t.Errorf("media = %s, want an attached photo", fields["media"])
+	}
+
+	if want := map[string]string{"media_file a.jpg": "JPEG"}; !reflect.DeepEqual(files, want) || fields["chat_id"] != "1" || fields["message_id"] != "2" {
+		t.Errorf("fields = %v, files = %v, want the address and %v", fields, files, want)
+	}
+
+	for _, media := range []InputMedia{"photo", (*InputMediaPhoto)(nil), SendPhoto{}} {
+		if _, _, err := EncodeRequest(EditMessageMedia{ChatID: &chatID, MessageID: &messageID, Media: media}); err == nil {
+			t.Errorf("EncodeRequest() of %#v media did not fail", media)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package telegram
+
+import "unicode/utf8"
+
+// UTF16Len returns the length of the text in UTF-16 code units, the unit of the offsets and lengths of the message entities.
+func UTF16Len(text string) int64 {
+	units := int64(0)
+
+	for _, r := range text {
+		units += utf16RuneLen(r)
+	}
+
+	return units
+}
+
+// UTF16ToByteOffset returns the byte offset of the text at the offset given in UTF-16 code units. It returns false if the
+// offset is out of the text or inside a surrogate pair.
+func UTF16ToByteOffset(text string, offset int64) (int, bool) {
+	if offset < 0 {
+		return 0, false
+	}
+
+	units := int64(0)
+
+	for i, r := range text {
+		if units == offset {
+			return i, true
+		}
+
+		if units > offset {
+			return 0, false
+		}
+
+		units += utf16RuneLen(r)
+	}
+
+	if units == offset {
+		return len(text), true
+	}
+
+	return 0, false
+}
+
+// ByteToUTF16Offset returns the offset in UTF-16 code units of the text at the byte offset. It returns false if the offset is
+// out of the text or inside a UTF-8 sequence.
+func ByteToUTF16Offset(text string, offset int) (int64, bool) {
+	units := int64(0)
+
+	for i, r := range text {
+		if i == offset {
+			return units, true
+		}
+
+		if i > offset {
+			return 0, false
+		}
+
+		units += utf16RuneLen(r)
+	}
+
+	if offset == len(text) {
+		return units, true
+	}
+
+	return 0, false
+}
+
+// utf16RuneLen returns the number of UTF-16 code units of the rune.
+func utf16RuneLen(r rune) int64 {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+
+	return 1
+}
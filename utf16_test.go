@@ -0,0 +1,105 @@
+package telegram
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestUTF16Len(t *testing.T) {
+	tests := []struct {
+		text string
+		want int64
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"héllo", 5},
+		{"é", 2},
+		{"😀", 2},
+		{"a😀b𝄞", 6},
+		{"👨‍👩‍👧", 8},
+	}
+
+	for _, test := range tests {
+		if got := UTF16Len(test.text); got != test.want {
+			t.Errorf("UTF16Len(%q) = %d, want %d", test.text, got, test.want)
+		}
+	}
+}
+
+func TestUTF16ToByteOffset(t *testing.T) {
+	tests := []struct {
+		text   string
+		offset int64
+		want   int
+		ok     bool
+	}{
+		{"abc", 0, 0, true},
+		{"abc", 3, 3, true},
+		{"abc", 4, 0, false},
+		{"abc", -1, 0, false},
+		{"😀a", 1, 0, false},
+		{"😀a", 2, 4, true},
+		{"😀a", 3, 5, true},
+		{"éx", 1, 1, true},
+		{"éx", 2, 3, true},
+	}
+
+	for _, test := range tests {
+		got, ok := UTF16ToByteOffset(test.text, test.offset)
+		if got != test.want || ok != test.ok {
+			t.Errorf("UTF16ToByteOffset(%q, %d) = %d, %v, want %d, %v", test.text, test.offset, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func FuzzUTF16ToByteOffset(f *testing.F) {
+	for _, seed := range []string{"", "abc", "😀", "a😀b𝄞c", "é̂", "👨‍👩‍👧", "\xff\xfe"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		units := utf16.Encode([]rune(text))
+		if UTF16Len(text) != int64(len(units)) {
+			t.Fatalf("UTF16Len(%q) = %d, want %d", text, UTF16Len(text), len(units))
+		}
+
+		for offset := int64(0); offset <= int64(len(units)); offset++ {
+			insidePair := offset < int64(len(units)) && units[offset] >= 0xdc00 && units[offset] <= 0xdfff
+
+			b, ok := UTF16ToByteOffset(text, offset)
+			if ok == insidePair {
+				t.Fatalf("UTF16ToByteOffset(%q, %d) ok = %v, inside a surrogate pair = %v", text, offset, ok, insidePair)
+			}
+
+			if !ok {
+				continue
+			}
+
+			if got := utf16.Encode([]rune(text[:b])); !equalUnits(got, units[:offset]) {
+				t.Fatalf("UTF16ToByteOffset(%q, %d) = %d, the text before it encodes to %v, want %v", text, offset, b, got, units[:offset])
+			}
+
+			if back, ok := ByteToUTF16Offset(text, b); !ok || back != offset {
+				t.Fatalf("ByteToUTF16Offset(%q, %d) = %d, %v, want %d, true", text, b, back, ok, offset)
+			}
+		}
+
+		if _, ok := UTF16ToByteOffset(text, int64(len(units))+1); ok {
+			t.Fatalf("UTF16ToByteOffset(%q, %d) is past the end of the text", text, len(units)+1)
+		}
+	})
+}
+
+func equalUnits(a, b []uint16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
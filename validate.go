@@ -0,0 +1,222 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidRequest is wrapped by the errors of the validation of the request structs.
+var ErrInvalidRequest = errors.New("telegram: invalid request")
+
+// ErrMessageAddress is returned by the validation of the methods addressing a message by chat_id and message_id or by
+// inline_message_id, if not exactly one of the two forms is set.
+var ErrMessageAddress = errors.New("telegram: either chat_id and message_id or inline_message_id must be set")
+
+// Validator is implemented by the request structs which can be checked before sending them. Client.Do validates them.
+type Validator interface {
+	Validate() error // Returns an error if the request is invalid
+}
+
+// FieldError is a field of a request struct breaking a constraint of the Bot API.
+type FieldError struct {
+	Field  string // Name of the field in the Bot API, e.g. text
+	Reason string // Constraint the field breaks, e.g. "must be 1-4096 characters long"
+	Err    error  // Optional. Error causing the field error, e.g. ErrMessageAddress
+}
+
+// Error returns the field error as e.g. "text must be 1-4096 characters long".
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
+// Unwrap returns the error causing the field error.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is returned by the Validate methods of the request structs. It wraps ErrInvalidRequest and the
+// errors causing its field errors.
+type ValidationError struct {
+	Method string       // Name of the method, e.g. sendMessage
+	Fields []FieldError // Fields breaking the constraints, in the order of the Bot API
+}
+
+// Error returns the validation error as e.g. "telegram: invalid request: sendMessage: text must be 1-4096 characters long".
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.Error())
+	}
+
+	return fmt.Sprintf("%v: %s: %s", ErrInvalidRequest, e.Method, strings.Join(fields, "; "))
+}
+
+// Unwrap returns ErrInvalidRequest and the errors causing the field errors.
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrInvalidRequest}
+
+	for _, f := range e.Fields {
+		if f.Err != nil {
+			errs = append(errs, f.Err)
+		}
+	}
+
+	return errs
+}
+
+// validation collects the field errors of a request struct. The Validate methods are generated from the limits of the
+// schema.
+type validation struct {
+	err ValidationError
+}
+
+// newValidation returns a validation of a request of the method.
+func newValidation(method string) *validation {
+	return &validation{err: ValidationError{Method: method}}
+}
+
+// result returns the *ValidationError of the field errors, or nil if there are none.
+func (v *validation) result() error {
+	if len(v.err.Fields) == 0 {
+		return nil
+	}
+
+	return &v.err
+}
+
+// fail adds a field error.
+func (v *validation) fail(field string, err error, format string, args ...interface{}) {
+	v.err.Fields = append(v.err.Fields, FieldError{Field: field, Reason: fmt.Sprintf(format, args...), Err: err})
+}
+
+// required checks that a required field is set.
+func (v *validation) required(field string, set bool) {
+	if !set {
+		v.fail(field, nil, "must be set")
+	}
+}
+
+// length checks the length of a string in UTF-16 code units. A max of 0 is no maximum.
+func (v *validation) length(field, s string, min, max int64) {
+	if n := UTF16Len(s); n < min || (max > 0 && n > max) {
+		v.fail(field, nil, "must be %s characters long, not %d", limitRange(min, max), n)
+	}
+}
+
+// formattedLength checks the length of a string written in the parse mode, after parsing its entities.
+func (v *validation) formattedLength(field, s string, parseMode *string, min, max int64) {
+	text, _, err := parseFormatted(s, parseMode)
+	if err != nil {
+		if parseMode != nil && !strings.EqualFold(*parseMode, Markdown) && !strings.EqualFold(*parseMode, HTML) {
+			v.fail("parse_mode", err, "must be %s or %s", Markdown, HTML)
+			return
+		}
+
+		v.fail(field, err, "can not be parsed: %v", err)
+
+		return
+	}
+
+	v.length(field, text, min, max)
+}
+
+// bytes checks the length of a string in bytes. A max of 0 is no maximum.
+func (v *validation) bytes(field, s string, min, max int64) {
+	if n := int64(len(s)); n < min || (max > 0 && n > max) {
+		v.fail(field, nil, "must be %s bytes long, not %d", limitRange(min, max), n)
+	}
+}
+
+// items checks the number of the items of an array. A max of 0 is no maximum.
+func (v *validation) items(field string, n, min, max int64) {
+	if n < min || (max > 0 && n > max) {
+		v.fail(field, nil, "must have %s items, not %d", limitRange(min, max), n)
+	}
+}
+
+// atLeast checks the minimum of a number.
+func (v *validation) atLeast(field string, x, min float64) {
+	if x < min {
+		v.fail(field, nil, "must be at least %g, not %g", min, x)
+	}
+}
+
+// atMost checks the maximum of a number.
+func (v *validation) atMost(field string, x, max float64) {
+	if x > max {
+		v.fail(field, nil, "must be at most %g, not %g", max, x)
+	}
+}
+
+// between checks the range of a number.
+func (v *validation) between(field string, x, min, max float64) {
+	if x < min || x > max {
+		v.fail(field, nil, "must be between %g and %g, not %g", min, max, x)
+	}
+}
+
+// matches checks that a string matches the pattern described by format.
+func (v *validation) matches(field, s string, pattern *regexp.Regexp, format string) {
+	if !pattern.MatchString(s) {
+		v.fail(field, nil, "%s, not %q", format, s)
+	}
+}
+
+// oneOf checks that a string is one of the values.
+func (v *validation) oneOf(field, s string, values ...string) {
+	for _, value := range values {
+		if s == value {
+			return
+		}
+	}
+
+	v.fail(field, nil, "must be one of %s, not %q", strings.Join(values, ", "), s)
+}
+
+// implies checks that the other boolean field is not false if the field is true.
+func (v *validation) implies(field string, set *bool, other string, otherSet *bool) {
+	if set != nil && *set && otherSet != nil && !*otherSet {
+		v.fail(field, nil, "implies %s, which is false", other)
+	}
+}
+
+// markup checks the reply markup if it can be validated, e.g. an InlineKeyboardMarkup or a pointer to it.
+func (v *validation) markup(field string, markup ReplyMarkup) {
+	validator, ok := markup.(Validator)
+	if !ok {
+		return
+	}
+
+	if value := reflect.ValueOf(markup); value.Kind() == reflect.Pointer && value.IsNil() {
+		return
+	}
+
+	if err := validator.Validate(); err != nil {
+		v.fail(field, err, "is invalid: %v", err)
+	}
+}
+
+// messageAddress checks that the message is addressed either by chat_id and message_id or by inline_message_id, given
+// which of them are set.
+func (v *validation) messageAddress(chatID, messageID, inlineMessageID bool) {
+	if (chatID && messageID && !inlineMessageID) || (!chatID && !messageID && inlineMessageID) {
+		return
+	}
+
+	v.fail("inline_message_id", ErrMessageAddress, "must be set if and only if chat_id and message_id are not set")
+}
+
+// limitRange returns the range of a limit, e.g. 1-4096 or at most 200. A max of 0 is no maximum.
+func limitRange(min, max int64) string {
+	switch {
+	case max == 0:
+		return fmt.Sprintf("at least %d", min)
+	case min == 0:
+		return fmt.Sprintf("at most %d", max)
+	}
+
+	return fmt.Sprintf("%d-%d", min, max)
+}
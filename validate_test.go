@@ -0,0 +1,134 @@
+package telegram
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ptr returns a pointer to the value, for the optional fields of the test requests.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestValidate(t *testing.T) {
+	invalidInline := InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: ""}}}}
+	validInline := InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "ok", CallbackData: ptr("data")}}}}
+	invalidReply := ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: ""}}}}
+	invoice := SendInvoice{ChatID: 1, Title: "t", Description: "d", Payload: "p", ProviderToken: "token", StartParameter: "s", Currency: "EUR", Prices: []LabeledPrice{{Label: "l", Amount: 1}}}
+	stickerSet := CreateNewStickerSet{UserID: 1, Name: "pack_by_bot", Title: "Pack", PngSticker: FileID("sticker"), Emojis: "😀"}
+
+	tests := []struct {
+		name    string
+		request Validator
+		invalid []string // Invalid fields, none if the request is valid
+	}{
+		{"text", SendMessage{ChatID: "1", Text: "hi"}, nil},
+		{"empty text", SendMessage{ChatID: "1"}, []string{"text"}},
+		{"longest text", SendMessage{ChatID: "1", Text: strings.Repeat("x", 4096)}, nil},
+		{"long text", SendMessage{ChatID: "1", Text: strings.Repeat("x", 4097)}, []string{"text"}},
+		{"text in UTF-16", SendMessage{ChatID: "1", Text: strings.Repeat("😀", 2049)}, []string{"text"}},
+		{"parsed text", SendMessage{ChatID: "1", Text: "*" + strings.Repeat("x", 4096) + "*", ParseMode: ptr(Markdown)}, nil},
+		{"unparsed text", SendMessage{ChatID: "1", Text: "*" + strings.Repeat("x", 4096) + "*"}, []string{"text"}},
+		{"invalid markdown", SendMessage{ChatID: "1", Text: "*bold", ParseMode: ptr(Markdown)}, []string{"text"}},
+		{"unknown parse mode", SendMessage{ChatID: "1", Text: "hi", ParseMode: ptr("BBCode")}, []string{"parse_mode"}},
+		{"chat id", SendMessage{Text: "hi"}, []string{"chat_id"}},
+		{"caption", SendPhoto{ChatID: "1", Photo: FileID("photo"), Caption: ptr(strings.Repeat("x", 201))}, []string{"caption"}},
+		{"photo", SendPhoto{ChatID: "1"}, []string{"photo"}},
+
+		{"inline keyboard", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: validInline}, nil},
+		{"invalid inline keyboard", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: invalidInline}, []string{"reply_markup"}},
+		{"invalid inline keyboard pointer", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: &invalidInline}, []string{"reply_markup"}},
+		{"nil inline keyboard pointer", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: (*InlineKeyboardMarkup)(nil)}, nil},
+		{"invalid reply keyboard", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: invalidReply}, []string{"reply_markup"}},
+		{"invalid reply keyboard pointer", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: &invalidReply}, []string{"reply_markup"}},
+		{"remove keyboard", SendMessage{ChatID: "1", Text: "hi", ReplyMarkup: ReplyKeyboardRemove{RemoveKeyboard: true}}, nil},
+		{"edited inline keyboard", EditMessageReplyMarkup{InlineMessageID: ptr("m"), ReplyMarkup: &invalidInline}, []string{"reply_markup"}},
+
+		{"payload", invoice, nil},
+		{"empty payload", func() SendInvoice { r := invoice; r.Payload = ""; return r }(), []string{"payload"}},
+		{"longest payload", func() SendInvoice { r := invoice; r.Payload = strings.Repeat("é", 64); return r }(), nil},
+		{"long payload", func() SendInvoice { r := invoice; r.Payload = strings.Repeat("x", 129); return r }(), []string{"payload"}},
+		{"no prices", func() SendInvoice { r := invoice; r.Prices = nil; return r }(), []string{"prices"}},
+		{"invoice title", func() SendInvoice { r := invoice; r.Title = strings.Repeat("x", 33); return r }(), []string{"title"}},
+
+		{"sticker set", stickerSet, nil},
+		{"sticker set without bot", func() CreateNewStickerSet { r := stickerSet; r.Name = "pack"; return r }(), []string{"name"}},
+		{"sticker set of a digit", func() CreateNewStickerSet { r := stickerSet; r.Name = "1pack_by_bot"; return r }(), []string{"name"}},
+		{"sticker set with double underscore", func() CreateNewStickerSet { r := stickerSet; r.Name = "pa__ck_by_bot"; return r }(), []string{"name"}},
+		{"sticker set without file", func() CreateNewStickerSet { r := stickerSet; r.PngSticker = nil; return r }(), []string{"png_sticker"}},
+
+		{"location", SendLocation{ChatID: "1", Latitude: 47.5, Longitude: 19.04, LivePeriod: ptr(int64(60))}, nil},
+		{"latitude", SendLocation{ChatID: "1", Latitude: 90.5, Longitude: 19.04}, []string{"latitude"}},
+		{"longitude", SendLocation{ChatID: "1", Latitude: 47.5, Longitude: -180.5}, []string{"longitude"}},
+		{"short live period", SendLocation{ChatID: "1", LivePeriod: ptr(int64(59))}, []string{"live_period"}},
+		{"long live period", SendLocation{ChatID: "1", LivePeriod: ptr(int64(86401))}, []string{"live_period"}},
+		{"venue", SendVenue{ChatID: "1", Latitude: -91, Longitude: 181, Title: "t", Address: "a"}, []string{"latitude", "longitude"}},
+
+		{"limit", GetUpdates{Limit: ptr(int64(100))}, nil},
+		{"zero limit", GetUpdates{Limit: ptr(int64(0))}, []string{"limit"}},
+		{"large limit", GetUpdates{Limit: ptr(int64(101))}, []string{"limit"}},
+		{"photos limit", GetUserProfilePhotos{UserID: 1, Limit: ptr(int64(101))}, []string{"limit"}},
+		{"callback cache time", AnswerCallbackQuery{CallbackQueryID: "1", CacheTime: ptr(int64(-1))}, []string{"cache_time"}},
+		{"inline cache time", AnswerInlineQuery{InlineQueryID: "1", Results: []byte("[]"), CacheTime: ptr(int64(-1))}, []string{"cache_time"}},
+		{"switch pm parameter", AnswerInlineQuery{InlineQueryID: "1", Results: []byte("[]"), SwitchPmParameter: ptr("a b")}, []string{"switch_pm_parameter"}},
+
+		{"restricted media", RestrictChatMember{ChatID: "1", UserID: 1, CanSendMessages: ptr(false), CanSendMediaMessages: ptr(true)}, []string{"can_send_media_messages"}},
+		{"restricted text", RestrictChatMember{ChatID: "1", UserID: 1, CanSendMessages: ptr(true), CanSendMediaMessages: ptr(false)}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.invalid == nil {
+				if err != nil {
+					t.Fatalf("Validate() = %v", err)
+				}
+
+				return
+			}
+
+			invalid := &ValidationError{}
+			if !errors.As(err, &invalid) || !errors.Is(err, ErrInvalidRequest) {
+				t.Fatalf("Validate() = %v, want a *ValidationError", err)
+			}
+
+			fields := []string{}
+			for _, f := range invalid.Fields {
+				fields = append(fields, f.Field)
+			}
+
+			if !reflect.DeepEqual(fields, test.invalid) {
+				t.Errorf("Validate() = %v, want the fields %v", err, test.invalid)
+			}
+		})
+	}
+}
+
+func TestValidateMessageAddress(t *testing.T) {
+	tests := []struct {
+		request EditMessageText
+		valid   bool
+	}{
+		{EditMessageText{ChatID: ptr("1"), MessageID: ptr(int64(2)), Text: "hi"}, true},
+		{EditMessageText{InlineMessageID: ptr("m"), Text: "hi"}, true},
+		{EditMessageText{Text: "hi"}, false},
+		{EditMessageText{ChatID: ptr("1"), Text: "hi"}, false},
+		{EditMessageText{ChatID: ptr("1"), MessageID: ptr(int64(2)), InlineMessageID: ptr("m"), Text: "hi"}, false},
+	}
+
+	for _, test := range tests {
+		err := test.request.Validate()
+		if (err == nil) != test.valid || (err != nil && !errors.Is(err, ErrMessageAddress)) {
+			t.Errorf("Validate(%+v) = %v, want valid %v", test.request, err, test.valid)
+		}
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := SendMessage{ChatID: "1"}.Validate()
+	if want := "telegram: invalid request: sendMessage: text must be 1-4096 characters long, not 0"; err == nil || err.Error() != want {
+		t.Errorf("Validate() = %v, want %s", err, want)
+	}
+}
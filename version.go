@@ -0,0 +1,180 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupported is returned by CheckVersion if a struct or a set field is newer than the version of the Bot API.
+var ErrUnsupported = errors.New("telegram: not supported by Bot API")
+
+// StructVersion tells which version of the Bot API added a struct of a type or method and its fields.
+type StructVersion struct {
+	Name   string         // Go name of the struct, e.g. SendMessage
+	Object string         // Name of the type or method in the Bot API, e.g. sendMessage
+	Since  string         // Version of the Bot API which added the type or method, empty if it is older than Bot API 2.0
+	Fields []FieldVersion // Fields of the struct, in the order of the Bot API
+}
+
+// FieldVersion tells which version of the Bot API added a field.
+type FieldVersion struct {
+	Name  string // Go name of the field, e.g. ChatID
+	JSON  string // Name of the field in the Bot API, e.g. chat_id
+	Since string // Version of the Bot API which added the field, empty if it is older than Bot API 2.0
+}
+
+// Available returns the structs available in the version of the Bot API, e.g. of a self-hosted Bot API server, with
+// only their fields available in it. The version is written as BotAPIVersion, e.g. 3.6.
+func Available(version string) []StructVersion {
+	available := []StructVersion{}
+
+	for _, s := range structVersions {
+		if compareVersions(s.Since, version) > 0 {
+			continue
+		}
+
+		fields := []FieldVersion{}
+		for _, f := range s.Fields {
+			if compareVersions(f.Since, version) <= 0 {
+				fields = append(fields, f)
+			}
+		}
+
+		s.Fields = fields
+		available = append(available, s)
+	}
+
+	return available
+}
+
+// CheckVersion returns an error wrapping ErrUnsupported if the struct, e.g. a request struct, is newer than the version
+// of the Bot API, or if it has set fields newer than it. The structs of its fields are checked too. A test can check the
+// requests of a service against the version of the Bot API server it uses.
+func CheckVersion(v interface{}, version string) error {
+	unsupported := []string{}
+	checkVersion(reflect.ValueOf(v), version, &unsupported)
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("%w %s: %s", ErrUnsupported, version, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// checkVersion adds the structs and the set fields of the value which are newer than the version to unsupported.
+func checkVersion(v reflect.Value, version string, unsupported *[]string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			checkVersion(v.Elem(), version, unsupported)
+		}
+
+		return
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			checkVersion(v.Index(i), version, unsupported)
+		}
+
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+
+	s := structVersion(v.Type())
+	if s != nil && compareVersions(s.Since, version) > 0 {
+		*unsupported = append(*unsupported, fmt.Sprintf("%s needs Bot API %s", s.Name, s.Since))
+		return
+	}
+
+	checkFields(v, s, version, unsupported)
+}
+
+// checkFields checks the set fields of the struct value against the versions of the struct s, which may be nil. The
+// fields of the embedded structs, e.g. the BaseResult of the inline query results, are checked as fields of s.
+func checkFields(v reflect.Value, s *StructVersion, version string, unsupported *[]string) {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+
+		if s != nil && sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			checkFields(v.Field(i), s, version, unsupported)
+			continue
+		}
+
+		if s != nil {
+			name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+			if f := s.field(name); f != nil && compareVersions(f.Since, version) > 0 {
+				*unsupported = append(*unsupported, fmt.Sprintf("%s.%s needs Bot API %s", s.Name, sf.Name, f.Since))
+				continue
+			}
+		}
+
+		checkVersion(v.Field(i), version, unsupported)
+	}
+}
+
+// structVersion returns the versions of the struct of the telegram package, or nil.
+func structVersion(t reflect.Type) *StructVersion {
+	if t.PkgPath() != reflect.TypeOf(StructVersion{}).PkgPath() {
+		return nil
+	}
+
+	for i := range structVersions {
+		if structVersions[i].Name == t.Name() {
+			return &structVersions[i]
+		}
+	}
+
+	return nil
+}
+
+// field returns the version of the field with the json name, or nil.
+func (s *StructVersion) field(name string) *FieldVersion {
+	for i := range s.Fields {
+		if s.Fields[i].JSON == name {
+			return &s.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// compareVersions compares two versions of the Bot API, e.g. 2.3.1 and 3.6, and returns -1, 0 or 1. The empty version,
+// older than Bot API 2.0, is older than every other.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	if a == "" {
+		as = nil
+	}
+
+	if b == "" {
+		bs = nil
+	}
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		x, y := 0, 0
+
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
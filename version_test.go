@@ -0,0 +1,121 @@
+package telegram
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewestVersion(t *testing.T) {
+	for _, s := range structVersions {
+		if compareVersions(s.Since, BotAPIVersion) > 0 {
+			t.Errorf("%s needs Bot API %s, newer than BotAPIVersion %s", s.Name, s.Since, BotAPIVersion)
+		}
+
+		for _, f := range s.Fields {
+			if compareVersions(f.Since, BotAPIVersion) > 0 {
+				t.Errorf("%s.%s needs Bot API %s, newer than BotAPIVersion %s", s.Name, f.Name, f.Since, BotAPIVersion)
+			}
+		}
+	}
+
+	if got := len(Available(BotAPIVersion)); got != len(structVersions) {
+		t.Errorf("Available(BotAPIVersion) has %d structs, want all %d", got, len(structVersions))
+	}
+}
+
+func TestAvailable(t *testing.T) {
+	find := func(structs []StructVersion, name string) *StructVersion {
+		for i := range structs {
+			if structs[i].Name == name {
+				return &structs[i]
+			}
+		}
+
+		return nil
+	}
+
+	if s := find(Available("3.6"), "EditMessageMedia"); s != nil {
+		t.Errorf("Available(3.6) has EditMessageMedia, added in 4.0")
+	}
+
+	if s := find(Available("4.0"), "EditMessageMedia"); s == nil {
+		t.Errorf("Available(4.0) has no EditMessageMedia")
+	}
+
+	if s := find(Available("3.4"), "InputMediaPhoto"); s != nil {
+		t.Errorf("Available(3.4) has InputMediaPhoto, added in 3.5")
+	}
+
+	photo := find(Available("3.5"), "InputMediaPhoto")
+	if photo == nil || photo.field("caption") == nil || photo.field("parse_mode") != nil {
+		t.Errorf("Available(3.5) InputMediaPhoto = %+v, want its fields without parse_mode", photo)
+	}
+
+	if s := find(Available(""), "Chat"); s == nil || s.field("id") == nil || s.field("all_members_are_administrators") != nil {
+		t.Errorf("Available(\"\") Chat = %+v, want its fields older than Bot API 2.0", s)
+	}
+
+	if got := len(Available("99.0")); got != len(structVersions) {
+		t.Errorf("Available(99.0) has %d structs, want all %d", got, len(structVersions))
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	media := EditMessageMedia{InlineMessageID: ptr("m"), Media: InputMediaPhoto{Media: FileID("p")}}
+	contact := SendContact{ChatID: "1", PhoneNumber: "1", FirstName: "a", Vcard: ptr("BEGIN:VCARD")}
+
+	tests := []struct {
+		name      string
+		request   interface{}
+		version   string
+		supported bool
+	}{
+		{"edit media", media, "4.0", true},
+		{"edit media before 4.0", media, "3.6", false},
+		{"animation", SendAnimation{ChatID: "1", Animation: FileID("a")}, "4.0", true},
+		{"animation before 4.0", SendAnimation{ChatID: "1", Animation: FileID("a")}, "3.6", false},
+		{"unset vcard", SendContact{ChatID: "1", PhoneNumber: "1", FirstName: "a"}, "3.6", true},
+		{"vcard", contact, "4.0", true},
+		{"vcard before 4.0", contact, "3.6", false},
+		{"thumbnail before 4.0", SendMediaGroup{ChatID: "1", Media: []InputMedia{&InputMediaVideo{Media: FileID("v"), Thumb: FileID("t")}}}, "3.6", false},
+		{"caption parse mode", SendPhoto{ChatID: "1", Photo: FileID("p"), ParseMode: ptr(HTML)}, "3.6", true},
+		{"caption parse mode before 3.6", SendPhoto{ChatID: "1", Photo: FileID("p"), ParseMode: ptr(HTML)}, "3.5", false},
+		{"album", SendMediaGroup{ChatID: "1", Media: []InputMedia{&InputMediaVideo{Media: FileID("v")}}}, "3.5", true},
+		{"album before 3.5", SendMediaGroup{ChatID: "1", Media: []InputMedia{&InputMediaVideo{Media: FileID("v")}}}, "3.4", false},
+		{"nested field", SendMediaGroup{ChatID: "1", Media: []InputMedia{&InputMediaVideo{Media: FileID("v"), ParseMode: ptr(HTML)}}}, "3.5", false},
+		{"old message", SendMessage{ChatID: "1", Text: "hi"}, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckVersion(test.request, test.version)
+			if test.supported && err != nil {
+				t.Errorf("CheckVersion(%q) = %v, want nil", test.version, err)
+			}
+
+			if !test.supported && !errors.Is(err, ErrUnsupported) {
+				t.Errorf("CheckVersion(%q) = %v, want ErrUnsupported", test.version, err)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"3.6", "4.0", -1},
+		{"4.0", "4", 0},
+		{"2.3.1", "2.3", 1},
+		{"", "2.0", -1},
+		{"", "", 0},
+		{"10.0", "9.1", 1},
+	}
+
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxBodySize is the default size limit in bytes of the updates received by a Webhook.
+const DefaultMaxBodySize = 1 << 20
+
+// Webhook is an http.Handler receiving the updates Telegram sends to the webhook of a bot. It passes the updates to its Handler
+// and responds with 200 OK even if the handler fails, so Telegram does not send the update again and again.
+type Webhook struct {
+	Handler     Handler     // Handler of the updates
+	MaxBodySize int64       // Optional. Size limit in bytes of an update. Defaults to DefaultMaxBodySize.
+	OnError     func(error) // Optional. Called with the errors of the handler and of writing the response.
+}
+
+// webhookReplyKey is the context key of the reply to an update received by a Webhook.
+type webhookReplyKey struct{}
+
+// webhookReply is the method sent back as the response to an update.
+type webhookReply struct {
+	mu     sync.Mutex
+	method Method
+}
+
+// WebhookReply makes the Webhook send the method back as the response to the update being handled, which saves a request
+// to the Bot API, but its result can not be known. It returns false if the update was not received by a Webhook, a reply was
+// already made or the method uploads a file. In that case the method must be sent with a Client. It also returns false if
+// the method implements Validator and is invalid, as its error could not be reported.
+func WebhookReply(ctx context.Context, method Method) bool {
+	reply, ok := ctx.Value(webhookReplyKey{}).(*webhookReply)
+	if !ok {
+		return false
+	}
+
+	if validator, ok := method.(Validator); ok && validator.Validate() != nil {
+		return false
+	}
+
+	request, attachments, err := attachMedia(method)
+	if err != nil || hasUpload(append(requestParams(request), attachments...)) {
+		return false
+	}
+
+	reply.mu.Lock()
+	defer reply.mu.Unlock()
+
+	if reply.method != nil {
+		return false
+	}
+
+	reply.method = request
+
+	return true
+}
+
+// ServeHTTP decodes the update and passes it to the handler.
+func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	maxBodySize := wh.MaxBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		maxBytesErr := &http.MaxBytesError{}
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	update, _, err := DecodeUpdate(body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	reply := &webhookReply{}
+
+	err = wh.Handler.HandleUpdate(context.WithValue(r.Context(), webhookReplyKey{}, reply), update)
+	if err != nil {
+		wh.report(err)
+	}
+
+	reply.mu.Lock()
+	method := reply.method
+	reply.mu.Unlock()
+
+	if method == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	data, err := encodeWebhookReply(method)
+	if err != nil {
+		wh.report(err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(data)
+	if err != nil {
+		wh.report(err)
+	}
+}
+
+// encodeWebhookReply encodes the method as JSON along with its name in the method field.
+func encodeWebhookReply(method Method) ([]byte, error) {
+	data, err := json.Marshal(method)
+	if err != nil {
+		return nil, encodingError(method, err)
+	}
+
+	fields := map[string]json.RawMessage{}
+
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, encodingError(method, err)
+	}
+
+	fields["method"], err = json.Marshal(method.MethodName())
+	if err != nil {
+		return nil, encodingError(method, err)
+	}
+
+	return json.Marshal(fields)
+}
+
+// report passes the error to OnError.
+func (wh *Webhook) report(err error) {
+	if wh.OnError != nil {
+		wh.OnError(err)
+	}
+}
@@ -0,0 +1,172 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testUpdate = `{"update_id":1,"message":{"message_id":2,"date":1,"chat":{"id":3,"type":"private"},"text":"ping"}}`
+
+// post posts the body to the webhook through a test server and returns the response.
+func post(t *testing.T, webhook *Webhook, method, contentType, body string) (*http.Response, string) {
+	t.Helper()
+
+	server := httptest.NewServer(webhook)
+	defer server.Close()
+
+	request, err := http.NewRequest(method, server.URL, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.Header.Set("Content-Type", contentType)
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response, string(data)
+}
+
+func TestWebhookReply(t *testing.T) {
+	webhook := &Webhook{Handler: HandlerFunc(func(ctx context.Context, update *Update) error {
+		if update.Message.Type != TextType {
+			t.Errorf("message type = %q", update.Message.Type)
+		}
+
+		if !WebhookReply(ctx, SendMessage{ChatID: "3", Text: "pong"}) {
+			t.Error("WebhookReply() = false")
+		}
+
+		if WebhookReply(ctx, SendMessage{ChatID: "3", Text: "again"}) {
+			t.Error("WebhookReply() replied twice")
+		}
+
+		return nil
+	})}
+
+	response, body := post(t, webhook, http.MethodPost, "application/json; charset=utf-8", testUpdate)
+	if response.StatusCode != http.StatusOK || response.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("response %s %q", response.Status, response.Header.Get("Content-Type"))
+	}
+
+	fields := map[string]string{}
+	if err := json.Unmarshal([]byte(body), &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	if fields["method"] != "sendMessage" || fields["chat_id"] != "3" || fields["text"] != "pong" || len(fields) != 3 {
+		t.Errorf("reply = %s", body)
+	}
+}
+
+func TestWebhookReplyInvalid(t *testing.T) {
+	webhook := &Webhook{Handler: HandlerFunc(func(ctx context.Context, update *Update) error {
+		if WebhookReply(ctx, SendMessage{ChatID: "3", Text: ""}) {
+			t.Error("WebhookReply() of an invalid message = true")
+		}
+
+		if WebhookReply(ctx, EditMessageText{Text: "no address"}) {
+			t.Error("WebhookReply() of an edit without a message = true")
+		}
+
+		if !WebhookReply(ctx, SendMessage{ChatID: "3", Text: "pong"}) {
+			t.Error("WebhookReply() after an invalid reply = false")
+		}
+
+		return nil
+	})}
+
+	if _, body := post(t, webhook, http.MethodPost, "application/json", testUpdate); !strings.Contains(body, `"text":"pong"`) {
+		t.Errorf("reply = %s", body)
+	}
+}
+
+func TestWebhookReplyUpload(t *testing.T) {
+	webhook := &Webhook{Handler: HandlerFunc(func(ctx context.Context, update *Update) error {
+		if WebhookReply(ctx, SendPhoto{ChatID: "3", Photo: FilePath("photo.jpg")}) {
+			t.Error("WebhookReply() of an upload = true")
+		}
+
+		if !WebhookReply(ctx, SendPhoto{ChatID: "3", Photo: FileID("photo")}) {
+			t.Error("WebhookReply() of a file_id = false")
+		}
+
+		return nil
+	})}
+
+	if _, body := post(t, webhook, http.MethodPost, "application/json", testUpdate); !strings.Contains(body, `"method":"sendPhoto"`) {
+		t.Errorf("reply = %s", body)
+	}
+
+	if WebhookReply(context.Background(), GetMe{}) {
+		t.Error("WebhookReply() outside of a webhook = true")
+	}
+}
+
+func TestWebhookHandlerError(t *testing.T) {
+	failure := errors.New("handler failed")
+	reported := []error{}
+
+	webhook := &Webhook{
+		Handler: HandlerFunc(func(ctx context.Context, update *Update) error { return failure }),
+		OnError: func(err error) { reported = append(reported, err) },
+	}
+
+	response, body := post(t, webhook, http.MethodPost, "application/json", testUpdate)
+	if response.StatusCode != http.StatusOK || body != "" {
+		t.Errorf("response %s %q, want 200 without a reply", response.Status, body)
+	}
+
+	if len(reported) != 1 || reported[0] != failure {
+		t.Errorf("reported %v, want the error of the handler", reported)
+	}
+}
+
+func TestWebhookInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"method", http.MethodGet, "application/json", "", http.StatusMethodNotAllowed},
+		{"content type", http.MethodPost, "text/plain", testUpdate, http.StatusUnsupportedMediaType},
+		{"no content type", http.MethodPost, "", testUpdate, http.StatusUnsupportedMediaType},
+		{"too large", http.MethodPost, "application/json", `{"update_id":1,"message":{"text":"` + strings.Repeat("x", 1024) + `"}}`, http.StatusRequestEntityTooLarge},
+		{"invalid json", http.MethodPost, "application/json", `{"update_id":`, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handled := false
+			webhook := &Webhook{
+				Handler:     HandlerFunc(func(ctx context.Context, update *Update) error { handled = true; return nil }),
+				MaxBodySize: 1024,
+			}
+
+			response, _ := post(t, webhook, test.method, test.contentType, test.body)
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+
+			if handled {
+				t.Error("the update was handled")
+			}
+		})
+	}
+}